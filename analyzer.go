@@ -16,6 +16,7 @@
 package gosec
 
 import (
+	"context"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -30,6 +31,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+	"unicode"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/buildssa"
@@ -56,6 +59,22 @@ const externalSuppressionJustification = "Globally suppressed."
 
 const aliasOfAllRules = "*"
 
+// NoSecWithoutReasonID is the rule ID of the issues reported for the #nosec
+// directives without justification when a justification is required
+const NoSecWithoutReasonID = "nosec"
+
+// FileDisableDirective is the comment directive which suppresses the listed rules,
+// or all the rules when none is listed, for the whole file
+const FileDisableDirective = "gosec:disable"
+
+var (
+	// justificationSeparator separates a suppression directive from its justification
+	justificationSeparator = regexp.MustCompile(`-{2,}`)
+	// noSecRuleIDPattern matches the rule IDs listed in a #nosec directive, including the IDs
+	// of the custom rules
+	noSecRuleIDPattern = regexp.MustCompile(`\b([A-Z]+[0-9]+)\b`)
+)
+
 type ignore struct {
 	start        int
 	end          int
@@ -158,19 +177,57 @@ func (ctx *Context) NewIssue(node ast.Node, ruleID, desc string,
 	return issue.New(ctx.GetFileAtNodePos(node), node, ruleID, desc, severity, confidence)
 }
 
+// NewLocation creates a new location related to an issue for the given node
+func (ctx *Context) NewLocation(node ast.Node, message string) issue.Location {
+	return issue.NewLocation(ctx.GetFileAtNodePos(node), node, message)
+}
+
+// NewFix creates a new fix suggesting to replace the code of the node with the replacement text
+func (ctx *Context) NewFix(node ast.Node, description, replacement string) *issue.Fix {
+	return issue.NewFix(ctx.GetFileAtNodePos(node), node, description, replacement)
+}
+
 // Metrics used when reporting information about a scanning run.
 type Metrics struct {
-	NumFiles int `json:"files"`
-	NumLines int `json:"lines"`
-	NumNosec int `json:"nosec"`
-	NumFound int `json:"found"`
+	NumFiles    int                    `json:"files"`
+	NumLines    int                    `json:"lines"`
+	NumNosec    int                    `json:"nosec"`
+	NumFound    int                    `json:"found"`
+	RuleTimings map[string]*RuleTiming `json:"rule_timings,omitempty" yaml:"rule_timings,omitempty"`
+	// SkippedFiles are the files larger than the maximum file size, which are loaded and
+	// type-checked with their package but whose issues are not reported
+	SkippedFiles []string `json:"skipped_files,omitempty" yaml:"skipped_files,omitempty"`
+}
+
+// RuleTiming is the cumulative time spent in a rule when the rule profiling is enabled
+type RuleTiming struct {
+	Duration    time.Duration `json:"duration"`
+	Invocations int           `json:"invocations"`
+}
+
+// addRuleTiming records an invocation of a rule
+func (m *Metrics) addRuleTiming(id string, duration time.Duration, invocations int) {
+	if m.RuleTimings == nil {
+		m.RuleTimings = map[string]*RuleTiming{}
+	}
+	timing, ok := m.RuleTimings[id]
+	if !ok {
+		timing = &RuleTiming{}
+		m.RuleTimings[id] = timing
+	}
+	timing.Duration += duration
+	timing.Invocations += invocations
 }
 
+// IssueHandler is invoked with each issue discovered by the analyzer
+type IssueHandler func(*issue.Issue)
+
 // Analyzer object is the main object of gosec. It has methods traverse an AST
 // and invoke the correct checking rules as on each node as required.
 type Analyzer struct {
 	ignoreNosec       bool
 	ruleset           RuleSet
+	ruleBuilders      map[string]RuleBuilder
 	context           *Context
 	config            Config
 	logger            *log.Logger
@@ -180,8 +237,18 @@ type Analyzer struct {
 	tests             bool
 	excludeGenerated  bool
 	showIgnored       bool
+	requireReason     bool
 	trackSuppressions bool
+	ruleOverrides     map[string]RuleOverride
+	excludedInTests   map[string]bool
+	excludedInFuzz    map[string]bool
+	fuzzTargets       map[string][]lineRange                      // keys are file paths; values are the lines of the fuzz targets in those files
+	disabledRules     map[string]map[string]issue.SuppressionInfo // keys are file paths; values are the rules disabled in those files
+	issueHandler      IssueHandler
+	retainIssues      bool
 	concurrency       int
+	profileRules      bool
+	maxFileSize       int64
 	analyzerList      []*analysis.Analyzer
 	mu                sync.Mutex
 }
@@ -196,13 +263,24 @@ func NewAnalyzer(conf Config, tests bool, excludeGenerated bool, trackSuppressio
 	if enabled, err := conf.IsGlobalEnabled(ShowIgnored); err == nil {
 		showIgnored = enabled
 	}
+	requireReason := false
+	if enabled, err := conf.IsGlobalEnabled(NoSecRequireReason); err == nil {
+		requireReason = enabled
+	}
 	if logger == nil {
 		logger = log.New(os.Stderr, "[gosec]", log.LstdFlags)
 	}
+	ruleOverrides, err := conf.GetRuleOverrides()
+	if err != nil {
+		logger.Printf("Ignoring the rule overrides: %s", err)
+	}
+	warnLegacyKeys(conf, logger)
 	return &Analyzer{
 		ignoreNosec:       ignoreNoSec,
 		showIgnored:       showIgnored,
+		requireReason:     requireReason,
 		ruleset:           NewRuleSet(),
+		ruleBuilders:      make(map[string]RuleBuilder),
 		context:           &Context{},
 		config:            conf,
 		logger:            logger,
@@ -213,6 +291,10 @@ func NewAnalyzer(conf Config, tests bool, excludeGenerated bool, trackSuppressio
 		concurrency:       concurrency,
 		excludeGenerated:  excludeGenerated,
 		trackSuppressions: trackSuppressions,
+		ruleOverrides:     ruleOverrides,
+		excludedInTests:   conf.GetRulesExcludedInTests(),
+		excludedInFuzz:    conf.GetRulesExcludedInFuzz(),
+		retainIssues:      true,
 		analyzerList:      analyzers.BuildDefaultAnalyzers(),
 	}
 }
@@ -220,6 +302,22 @@ func NewAnalyzer(conf Config, tests bool, excludeGenerated bool, trackSuppressio
 // SetConfig updates the analyzer configuration
 func (gosec *Analyzer) SetConfig(conf Config) {
 	gosec.config = conf
+	ruleOverrides, err := conf.GetRuleOverrides()
+	if err != nil {
+		gosec.logger.Printf("Ignoring the rule overrides: %s", err)
+	}
+	gosec.ruleOverrides = ruleOverrides
+	gosec.excludedInTests = conf.GetRulesExcludedInTests()
+	gosec.excludedInFuzz = conf.GetRulesExcludedInFuzz()
+	warnLegacyKeys(conf, gosec.logger)
+}
+
+// warnLegacyKeys logs a warning for each rule setting of the configuration which is not
+// namespaced under a rule ID, hence ignored by the rules
+func warnLegacyKeys(conf Config, logger *log.Logger) {
+	for _, key := range conf.LegacyKeys() {
+		logger.Printf("Ignoring the config key %q: the rule settings must be set under the rule ID, e.g. {\"G101\": {%q: ...}}", key, key)
+	}
 }
 
 // Config returns the current configuration
@@ -230,15 +328,88 @@ func (gosec *Analyzer) Config() Config {
 // LoadRules instantiates all the rules to be used when analyzing source
 // packages
 func (gosec *Analyzer) LoadRules(ruleDefinitions map[string]RuleBuilder, ruleSuppressed map[string]bool) {
+	conf := gosec.config.withLogger(gosec.logger)
 	for id, def := range ruleDefinitions {
-		r, nodes := def(id, gosec.config)
+		r, nodes := def(id, conf)
 		gosec.ruleset.Register(r, ruleSuppressed[id], nodes...)
+		gosec.ruleBuilders[id] = def
+	}
+}
+
+// worker creates an analyzer with the same configuration, which owns new instances
+// of the loaded rules, its own context and its own results. The rules may keep some
+// state while walking the AST, hence the instances are never shared between workers.
+func (gosec *Analyzer) worker() *Analyzer {
+	w := &Analyzer{
+		ignoreNosec:       gosec.ignoreNosec,
+		ruleset:           NewRuleSet(),
+		ruleBuilders:      gosec.ruleBuilders,
+		context:           &Context{},
+		config:            gosec.config,
+		logger:            gosec.logger,
+		issues:            make([]*issue.Issue, 0, 16),
+		stats:             &Metrics{},
+		errors:            make(map[string][]Error),
+		tests:             gosec.tests,
+		excludeGenerated:  gosec.excludeGenerated,
+		showIgnored:       gosec.showIgnored,
+		requireReason:     gosec.requireReason,
+		trackSuppressions: gosec.trackSuppressions,
+		ruleOverrides:     gosec.ruleOverrides,
+		excludedInTests:   gosec.excludedInTests,
+		excludedInFuzz:    gosec.excludedInFuzz,
+		retainIssues:      gosec.retainIssues,
+		concurrency:       1,
+		profileRules:      gosec.profileRules,
+		maxFileSize:       gosec.maxFileSize,
+		analyzerList:      gosec.analyzerList,
+	}
+	if gosec.issueHandler != nil {
+		// The handler is shared by all the workers.
+		w.issueHandler = func(i *issue.Issue) {
+			gosec.mu.Lock()
+			defer gosec.mu.Unlock()
+			gosec.issueHandler(i)
+		}
+	}
+	conf := gosec.config.withLogger(gosec.logger)
+	for id, def := range gosec.ruleBuilders {
+		r, nodes := def(id, conf)
+		w.ruleset.Register(r, gosec.ruleset.IsRuleSuppressed(id), nodes...)
+	}
+	return w
+}
+
+// merge collects the results of a worker
+func (gosec *Analyzer) merge(w *Analyzer) {
+	gosec.mu.Lock()
+	defer gosec.mu.Unlock()
+	gosec.issues = append(gosec.issues, w.issues...)
+	gosec.stats.NumFiles += w.stats.NumFiles
+	gosec.stats.NumLines += w.stats.NumLines
+	gosec.stats.NumNosec += w.stats.NumNosec
+	gosec.stats.NumFound += w.stats.NumFound
+	gosec.stats.SkippedFiles = append(gosec.stats.SkippedFiles, w.stats.SkippedFiles...)
+	for id, timing := range w.stats.RuleTimings {
+		gosec.stats.addRuleTiming(id, timing.Duration, timing.Invocations)
+	}
+	for file, errs := range w.errors {
+		gosec.errors[file] = append(gosec.errors[file], errs...)
 	}
 }
 
 // Process kicks off the analysis process for a given package
 func (gosec *Analyzer) Process(buildTags []string, packagePaths ...string) error {
+	return gosec.ProcessContext(context.Background(), buildTags, packagePaths...)
+}
+
+// ProcessContext kicks off the analysis process for a given package. The analysis
+// is aborted with an error wrapping the context error when the context is cancelled
+// or when its deadline is exceeded. The context is checked between the packages and
+// between the files of a package.
+func (gosec *Analyzer) ProcessContext(ctx context.Context, buildTags []string, packagePaths ...string) error {
 	config := &packages.Config{
+		Context:    ctx,
 		Mode:       LoadMode,
 		BuildFlags: buildTags,
 		Tests:      gosec.tests,
@@ -260,6 +431,10 @@ func (gosec *Analyzer) Process(buildTags []string, packagePaths ...string) error
 		for {
 			select {
 			case s := <-j:
+				if ctx.Err() != nil {
+					wg.Done()
+					return
+				}
 				pkgs, err := gosec.load(s, config)
 				select {
 				case r <- result{pkgPath: s, pkgs: pkgs, err: err}:
@@ -292,24 +467,89 @@ func (gosec *Analyzer) Process(buildTags []string, packagePaths ...string) error
 		close(results)
 	}()
 
+	checks, done := gosec.startCheckers(ctx)
 	for r := range results {
+		if err := ctx.Err(); err != nil {
+			close(quit)
+			wg.Wait() // wait for the goroutines to stop
+			done()
+			return fmt.Errorf("analysis aborted: %w", err)
+		}
 		if r.err != nil {
 			gosec.AppendError(r.pkgPath, r.err)
 		}
-		for _, pkg := range r.pkgs {
-			if pkg.Name != "" {
-				err := gosec.ParseErrors(pkg)
-				if err != nil {
-					close(quit)
-					wg.Wait() // wait for the goroutines to stop
-					return fmt.Errorf("parsing errors in pkg %q: %w", pkg.Name, err)
+		if err := gosec.dispatch(ctx, checks, r.pkgs); err != nil {
+			close(quit)
+			wg.Wait() // wait for the goroutines to stop
+			done()
+			return err
+		}
+	}
+	done()
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("analysis aborted: %w", err)
+	}
+	sortErrors(gosec.errors)
+	return nil
+}
+
+// ProcessPackages analyzes packages which are already loaded, e.g. by an editor
+// which keeps its own package cache. The packages must be loaded at least with
+// the syntax and the type information described by LoadMode.
+func (gosec *Analyzer) ProcessPackages(pkgs ...*packages.Package) error {
+	checks, done := gosec.startCheckers(context.Background())
+	err := gosec.dispatch(context.Background(), checks, pkgs)
+	done()
+	if err != nil {
+		return err
+	}
+	sortErrors(gosec.errors)
+	return nil
+}
+
+// startCheckers starts the workers which check the packages sent on the returned
+// channel. The packages are checked concurrently by workers owning their own rule
+// instances. The returned function waits for the workers to collect their results.
+func (gosec *Analyzer) startCheckers(ctx context.Context) (chan<- *packages.Package, func()) {
+	checks := make(chan *packages.Package)
+	var wg sync.WaitGroup
+	workers := gosec.concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(w *Analyzer) {
+			defer wg.Done()
+			for pkg := range checks {
+				if ctx.Err() != nil {
+					continue
 				}
-				gosec.CheckRules(pkg)
-				gosec.CheckAnalyzers(pkg)
+				w.checkRules(ctx, pkg)
+				w.CheckAnalyzers(pkg)
+			}
+			gosec.merge(w)
+		}(gosec.worker())
+	}
+	return checks, func() {
+		close(checks)
+		wg.Wait()
+	}
+}
+
+// dispatch records the errors of the packages and sends them to the checkers
+func (gosec *Analyzer) dispatch(ctx context.Context, checks chan<- *packages.Package, pkgs []*packages.Package) error {
+	for _, pkg := range pkgs {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("analysis aborted: %w", err)
+		}
+		if pkg.Name != "" {
+			if err := gosec.ParseErrors(pkg); err != nil {
+				return fmt.Errorf("parsing errors in pkg %q: %w", pkg.Name, err)
 			}
+			checks <- pkg
 		}
 	}
-	sortErrors(gosec.errors)
 	return nil
 }
 
@@ -324,9 +564,7 @@ func (gosec *Analyzer) load(pkgPath string, conf *packages.Config) ([]*packages.
 	// step 1/3 create build context.
 	buildD := build.Default
 	// step 2/3: add build tags to get env dependent files into basePackage.
-	gosec.mu.Lock()
 	buildD.BuildTags = conf.BuildFlags
-	gosec.mu.Unlock()
 	basePackage, err := buildD.ImportDir(pkgPath, build.ImportComment)
 	if err != nil {
 		return []*packages.Package{}, fmt.Errorf("importing dir %q: %w", pkgPath, err)
@@ -349,21 +587,43 @@ func (gosec *Analyzer) load(pkgPath string, conf *packages.Config) ([]*packages.
 		}
 	}
 
-	// step 3/3 remove build tags from conf to proceed build correctly.
-	gosec.mu.Lock()
-	conf.BuildFlags = nil
-	defer gosec.mu.Unlock()
-	pkgs, err := packages.Load(conf, packageFiles...)
+	// step 3/3 remove build tags from a copy of conf to proceed build correctly,
+	// the configuration is shared by the workers loading the packages concurrently.
+	loadConf := *conf
+	loadConf.BuildFlags = nil
+	pkgs, err := packages.Load(&loadConf, packageFiles...)
 	if err != nil {
 		return []*packages.Package{}, fmt.Errorf("loading files from package %q: %w", pkgPath, err)
 	}
 	return pkgs, nil
 }
 
+// fileSize returns the size of the file when it is larger than the maximum file size. The
+// large files are still loaded to type-check their package, but their issues are not reported.
+func (gosec *Analyzer) fileSize(file string) (int64, bool) {
+	if gosec.maxFileSize <= 0 {
+		return 0, false
+	}
+	info, err := os.Stat(file)
+	if err != nil || info.Size() <= gosec.maxFileSize {
+		return 0, false
+	}
+	return info.Size(), true
+}
+
 // CheckRules runs analysis on the given package.
 func (gosec *Analyzer) CheckRules(pkg *packages.Package) {
+	gosec.checkRules(context.Background(), pkg)
+}
+
+// checkRules runs analysis on the given package until the context is done
+func (gosec *Analyzer) checkRules(ctx context.Context, pkg *packages.Package) {
 	gosec.logger.Println("Checking package:", pkg.Name)
+	gosec.updateFuzzTargets(pkg)
 	for _, file := range pkg.Syntax {
+		if ctx.Err() != nil {
+			return
+		}
 		fp := pkg.Fset.File(file.Pos())
 		if fp == nil {
 			// skip files which cannot be located
@@ -379,6 +639,11 @@ func (gosec *Analyzer) CheckRules(pkg *packages.Package) {
 			gosec.logger.Println("Ignoring generated file:", checkedFile)
 			continue
 		}
+		if size, large := gosec.fileSize(checkedFile); large {
+			gosec.logger.Printf("Skipping file larger than %d bytes: %s (%d bytes)", gosec.maxFileSize, checkedFile, size)
+			gosec.stats.SkippedFiles = append(gosec.stats.SkippedFiles, checkedFile)
+			continue
+		}
 
 		gosec.logger.Println("Checking file:", checkedFile)
 		gosec.context.FileSet = pkg.Fset
@@ -392,6 +657,7 @@ func (gosec *Analyzer) CheckRules(pkg *packages.Package) {
 		gosec.context.PassedValues = make(map[string]interface{})
 		gosec.context.Ignores = newIgnores()
 		gosec.updateIgnores()
+		gosec.updateDisabledRules(checkedFile, file)
 		ast.Walk(gosec, file)
 		gosec.stats.NumFiles++
 		gosec.stats.NumLines += pkg.Fset.File(file.Pos()).LineCount()
@@ -415,6 +681,7 @@ func (gosec *Analyzer) CheckAnalyzers(pkg *packages.Package) {
 	}
 
 	generatedFiles := gosec.generatedFiles(pkg)
+	largeFiles := gosec.largeFiles(pkg)
 
 	for _, analyzer := range gosec.analyzerList {
 		pass := &analysis.Pass{
@@ -435,7 +702,11 @@ func (gosec *Analyzer) CheckAnalyzers(pkg *packages.Package) {
 			AllObjectFacts:    nil,
 			AllPackageFacts:   nil,
 		}
+		start := time.Now()
 		result, err := pass.Analyzer.Run(pass)
+		if gosec.profileRules {
+			gosec.stats.addRuleTiming(analyzer.Name, time.Since(start), 1)
+		}
 		if err != nil {
 			gosec.logger.Printf("Error running analyzer %s: %s\n", analyzer.Name, err)
 			continue
@@ -448,6 +719,9 @@ func (gosec *Analyzer) CheckAnalyzers(pkg *packages.Package) {
 							continue
 						}
 					}
+					if largeFiles[iss.File] {
+						continue
+					}
 					gosec.updateIssues(iss)
 				}
 			}
@@ -470,6 +744,22 @@ func (gosec *Analyzer) generatedFiles(pkg *packages.Package) map[string]bool {
 	return generatedFiles
 }
 
+// largeFiles returns the files of the package larger than the maximum file size
+func (gosec *Analyzer) largeFiles(pkg *packages.Package) map[string]bool {
+	largeFiles := map[string]bool{}
+	for _, file := range pkg.Syntax {
+		fp := pkg.Fset.File(file.Pos())
+		if fp == nil {
+			// skip files which cannot be located
+			continue
+		}
+		if _, large := gosec.fileSize(fp.Name()); large {
+			largeFiles[fp.Name()] = true
+		}
+	}
+	return largeFiles
+}
+
 // buildSSA runs the SSA pass which builds the SSA representation of the package. It handles gracefully any panic.
 func (gosec *Analyzer) buildSSA(pkg *packages.Package) (interface{}, error) {
 	defer func() {
@@ -552,67 +842,112 @@ func (gosec *Analyzer) AppendError(file string, err error) {
 func (gosec *Analyzer) ignore(n ast.Node) map[string]issue.SuppressionInfo {
 	if groups, ok := gosec.context.Comments[n]; ok && !gosec.ignoreNosec {
 
-		// Checks if an alternative for #nosec is set and, if not, uses the default.
-		noSecDefaultTag, err := gosec.config.GetGlobal(Nosec)
-		if err != nil {
-			noSecDefaultTag = NoSecTag(string(Nosec))
-		} else {
-			noSecDefaultTag = NoSecTag(noSecDefaultTag)
+		tags := gosec.noSecTags()
+		for _, group := range groups {
+			for _, tag := range tags {
+				comment := strings.TrimSpace(group.Text())
+				if strings.Contains(tag, ":") {
+					// The Go directives are removed from the text of the comment group.
+					comment = directiveText(group)
+				}
+				if strings.HasPrefix(comment, tag) || regexp.MustCompile("\n *"+regexp.QuoteMeta(tag)).MatchString(comment) {
+					// Discard what's in front of the nosec tag.
+					comment = strings.SplitN(comment, tag, 2)[1]
+					return gosec.parseNoSec(group, comment)
+				}
+			}
 		}
-		noSecAlternativeTag, err := gosec.config.GetGlobal(NoSecAlternative)
-		if err != nil {
-			noSecAlternativeTag = noSecDefaultTag
+	}
+	return nil
+}
+
+// noSecTags returns the comment prefixes which suppress the issues. A custom tag
+// replaces the default #nosec, while an alternative tag is accepted alongside it.
+func (gosec *Analyzer) noSecTags() []string {
+	if customTag, err := gosec.config.GetGlobal(NoSecCustomTag); err == nil && customTag != "" {
+		if directive, err := NoSecDirective(customTag); err == nil {
+			return []string{directive}
+		}
+	}
+
+	// Checks if an alternative for #nosec is set and, if not, uses the default.
+	noSecDefaultTag, err := gosec.config.GetGlobal(Nosec)
+	if err != nil {
+		noSecDefaultTag = NoSecTag(string(Nosec))
+	} else {
+		noSecDefaultTag = NoSecTag(noSecDefaultTag)
+	}
+	noSecAlternativeTag, err := gosec.config.GetGlobal(NoSecAlternative)
+	if err != nil {
+		return []string{noSecDefaultTag}
+	}
+	return []string{noSecDefaultTag, NoSecTag(noSecAlternativeTag)}
+}
+
+// directiveText returns the text of the comment group including the Go directives
+func directiveText(group *ast.CommentGroup) string {
+	lines := make([]string, 0, len(group.List))
+	for _, c := range group.List {
+		text := c.Text
+		if strings.HasPrefix(text, "//") {
+			text = text[2:]
 		} else {
-			noSecAlternativeTag = NoSecTag(noSecAlternativeTag)
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
 		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+	return strings.Join(lines, "\n")
+}
 
-		for _, group := range groups {
-			comment := strings.TrimSpace(group.Text())
-			foundDefaultTag := strings.HasPrefix(comment, noSecDefaultTag) || regexp.MustCompile("\n *"+noSecDefaultTag).MatchString(comment)
-			foundAlternativeTag := strings.HasPrefix(comment, noSecAlternativeTag) || regexp.MustCompile("\n *"+noSecAlternativeTag).MatchString(comment)
-
-			if foundDefaultTag || foundAlternativeTag {
-				gosec.stats.NumNosec++
-
-				// Discard what's in front of the nosec tag.
-				if foundDefaultTag {
-					comment = strings.SplitN(comment, noSecDefaultTag, 2)[1]
-				} else {
-					comment = strings.SplitN(comment, noSecAlternativeTag, 2)[1]
-				}
+// parseNoSec extracts the suppressed rules and the justification from the text
+// following a nosec tag
+func (gosec *Analyzer) parseNoSec(group *ast.CommentGroup, comment string) map[string]issue.SuppressionInfo {
+	// Extract the directive and the justification.
+	justification := ""
+	commentParts := justificationSeparator.Split(comment, 2)
+	directive := commentParts[0]
+	if len(commentParts) > 1 {
+		justification = strings.TrimSpace(strings.TrimRight(commentParts[1], "\n"))
+	}
 
-				// Extract the directive and the justification.
-				justification := ""
-				commentParts := regexp.MustCompile(`-{2,}`).Split(comment, 2)
-				directive := commentParts[0]
-				if len(commentParts) > 1 {
-					justification = strings.TrimSpace(strings.TrimRight(commentParts[1], "\n"))
-				}
+	// A directive without justification does not suppress anything when a justification is required.
+	if gosec.requireReason && justification == "" {
+		gosec.reportNoSecWithoutReason(group, "#nosec")
+		return nil
+	}
+	gosec.stats.NumNosec++
 
-				// Pull out the specific rules that are listed to be ignored.
-				re := regexp.MustCompile(`(G\d{3})`)
-				matches := re.FindAllStringSubmatch(directive, -1)
+	// Pull out the specific rules that are listed to be ignored.
+	matches := noSecRuleIDPattern.FindAllStringSubmatch(directive, -1)
 
-				suppression := issue.SuppressionInfo{
-					Kind:          "inSource",
-					Justification: justification,
-				}
+	suppression := issue.SuppressionInfo{
+		Kind:          "inSource",
+		Justification: justification,
+	}
 
-				// Find the rule IDs to ignore.
-				ignores := make(map[string]issue.SuppressionInfo)
-				for _, v := range matches {
-					ignores[v[1]] = suppression
-				}
+	// Find the rule IDs to ignore.
+	ignores := make(map[string]issue.SuppressionInfo)
+	for _, v := range matches {
+		ignores[v[1]] = suppression
+	}
 
-				// If no specific rules were given, ignore everything.
-				if len(matches) == 0 {
-					ignores[aliasOfAllRules] = suppression
-				}
-				return ignores
-			}
-		}
+	// If no specific rules were given, ignore everything.
+	if len(matches) == 0 {
+		ignores[aliasOfAllRules] = suppression
 	}
-	return nil
+	return ignores
+}
+
+// reportNoSecWithoutReason reports an issue at the location of a #nosec or gosec:disable
+// directive which does not provide a justification
+func (gosec *Analyzer) reportNoSecWithoutReason(node ast.Node, directive string) {
+	fobj := gosec.context.FileSet.File(node.Pos())
+	if fobj == nil {
+		return
+	}
+	gosec.report(issue.New(fobj, node, NoSecWithoutReasonID,
+		directive+" directive without justification does not suppress any issue", issue.Low, issue.High))
+	gosec.stats.NumFound++
 }
 
 // Visit runs the gosec visitor logic over an AST created by parsing go code.
@@ -625,7 +960,14 @@ func (gosec *Analyzer) Visit(n ast.Node) ast.Visitor {
 	}
 
 	for _, rule := range gosec.ruleset.RegisteredFor(n) {
+		var start time.Time
+		if gosec.profileRules {
+			start = time.Now()
+		}
 		issue, err := rule.Match(n, gosec.context)
+		if gosec.profileRules {
+			gosec.stats.addRuleTiming(rule.ID(), time.Since(start), 1)
+		}
 		if err != nil {
 			file, line := GetLocation(n, gosec.context)
 			file = path.Base(file)
@@ -657,6 +999,70 @@ func (gosec *Analyzer) updateIgnoredRulesForNode(n ast.Node) {
 	}
 }
 
+// updateDisabledRules records the rules disabled for the whole file by the
+// gosec:disable directives found before the package clause or in the first comment
+func (gosec *Analyzer) updateDisabledRules(name string, file *ast.File) {
+	if gosec.ignoreNosec {
+		return
+	}
+	for i, group := range file.Comments {
+		if i > 0 && group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			disabled := gosec.parseDisableDirective(c)
+			if len(disabled) == 0 {
+				continue
+			}
+			if gosec.disabledRules == nil {
+				gosec.disabledRules = make(map[string]map[string]issue.SuppressionInfo)
+			}
+			if gosec.disabledRules[name] == nil {
+				gosec.disabledRules[name] = make(map[string]issue.SuppressionInfo)
+			}
+			for id, suppression := range disabled {
+				gosec.disabledRules[name][id] = suppression
+			}
+		}
+	}
+}
+
+// parseDisableDirective extracts the rules disabled by a gosec:disable comment. The rule
+// IDs are separated by commas or spaces and they can be followed by a justification
+// introduced with "--".
+func (gosec *Analyzer) parseDisableDirective(comment *ast.Comment) map[string]issue.SuppressionInfo {
+	text, ok := strings.CutPrefix(comment.Text, "//"+FileDisableDirective)
+	if !ok || (text != "" && !strings.ContainsAny(text[:1], " \t,")) {
+		return nil
+	}
+
+	justification := ""
+	parts := justificationSeparator.Split(text, 2)
+	if len(parts) > 1 {
+		justification = strings.TrimSpace(parts[1])
+	}
+
+	// Like the #nosec directives, it does not disable anything when a justification is required.
+	if gosec.requireReason && justification == "" {
+		gosec.reportNoSecWithoutReason(comment, FileDisableDirective)
+		return nil
+	}
+	gosec.stats.NumNosec++
+	suppression := issue.SuppressionInfo{
+		Kind:          "inSource",
+		Justification: justification,
+	}
+
+	disabled := make(map[string]issue.SuppressionInfo)
+	for _, id := range strings.FieldsFunc(parts[0], func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		disabled[id] = suppression
+	}
+	if len(disabled) == 0 {
+		disabled[aliasOfAllRules] = suppression
+	}
+	return disabled
+}
+
 func (gosec *Analyzer) getSuppressionsAtLineInFile(file string, line string, id string) ([]issue.SuppressionInfo, bool) {
 	ignoredRules := gosec.context.Ignores.get(file, line)
 
@@ -666,6 +1072,14 @@ func (gosec *Analyzer) getSuppressionsAtLineInFile(file string, line string, id
 	ignored := generalIgnored || ruleIgnored
 	suppressions := append(generalSuppressions, ruleSuppressions...)
 
+	// Check if the rule was disabled for the whole file.
+	for _, rule := range []string{aliasOfAllRules, id} {
+		if suppression, ok := gosec.disabledRules[file][rule]; ok {
+			ignored = true
+			suppressions = append(suppressions, suppression)
+		}
+	}
+
 	// Track external suppressions of this rule.
 	if gosec.ruleset.IsRuleSuppressed(id) {
 		ignored = true
@@ -677,8 +1091,109 @@ func (gosec *Analyzer) getSuppressionsAtLineInFile(file string, line string, id
 	return suppressions, ignored
 }
 
+// applyRuleOverrides replaces the severity and the confidence of the issue
+// with the ones configured for its rule
+func (gosec *Analyzer) applyRuleOverrides(issue *issue.Issue) {
+	override, ok := gosec.ruleOverrides[issue.RuleID]
+	if !ok {
+		return
+	}
+	if override.Severity != nil {
+		issue.Severity = *override.Severity
+	}
+	if override.Confidence != nil {
+		issue.Confidence = *override.Confidence
+	}
+}
+
+// isExcludedInTests checks if the issue is found in a test file by a rule which is
+// not reported in the test files
+func (gosec *Analyzer) isExcludedInTests(issue *issue.Issue) bool {
+	return gosec.excludedInTests[issue.RuleID] && strings.HasSuffix(issue.File, "_test.go")
+}
+
+// lineRange is a range of lines of a file, both ends included
+type lineRange struct {
+	start, end int
+}
+
+// isFuzzTarget checks if the function is a fuzz target, e.g. func FuzzParse(f *testing.F)
+func isFuzzTarget(fn *ast.FuncDecl, info *types.Info) bool {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Fuzz") || fn.Type.Params.NumFields() != 1 {
+		return false
+	}
+	t := info.TypeOf(fn.Type.Params.List[0].Type)
+	return t != nil && t.String() == "*testing.F"
+}
+
+// updateFuzzTargets records the lines of the fuzz targets of the package, along with the lines
+// of the named functions they pass to f.Fuzz, when some rules are excluded in the fuzz targets
+func (gosec *Analyzer) updateFuzzTargets(pkg *packages.Package) {
+	if len(gosec.excludedInFuzz) == 0 || pkg.TypesInfo == nil {
+		return
+	}
+	decls := make(map[types.Object]*ast.FuncDecl)
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				if obj := pkg.TypesInfo.Defs[fn.Name]; obj != nil {
+					decls[obj] = fn
+				}
+			}
+		}
+	}
+	add := func(fn *ast.FuncDecl) {
+		start := pkg.Fset.Position(fn.Pos())
+		end := pkg.Fset.Position(fn.End())
+		if gosec.fuzzTargets == nil {
+			gosec.fuzzTargets = make(map[string][]lineRange)
+		}
+		gosec.fuzzTargets[start.Filename] = append(gosec.fuzzTargets[start.Filename], lineRange{start.Line, end.Line})
+	}
+	for _, fn := range decls {
+		if !isFuzzTarget(fn, pkg.TypesInfo) {
+			continue
+		}
+		add(fn)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Fuzz" {
+				return true
+			}
+			if ident, ok := call.Args[0].(*ast.Ident); ok {
+				if fuzzed, ok := decls[pkg.TypesInfo.Uses[ident]]; ok {
+					add(fuzzed)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// isExcludedInFuzz checks if the issue is found in a fuzz target, or in a function fuzzed
+// by a fuzz target, by a rule which is not reported in the fuzz targets
+func (gosec *Analyzer) isExcludedInFuzz(issue *issue.Issue) bool {
+	if !gosec.excludedInFuzz[issue.RuleID] {
+		return false
+	}
+	line, err := strconv.Atoi(strings.Split(issue.Line, "-")[0])
+	if err != nil {
+		return false
+	}
+	for _, r := range gosec.fuzzTargets[issue.File] {
+		if line >= r.start && line <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
 func (gosec *Analyzer) updateIssues(issue *issue.Issue) {
-	if issue != nil {
+	if issue != nil && !gosec.isExcludedInTests(issue) && !gosec.isExcludedInFuzz(issue) {
+		gosec.applyRuleOverrides(issue)
 		suppressions, ignored := gosec.getSuppressionsAtLineInFile(issue.File, issue.Line, issue.RuleID)
 		if gosec.showIgnored {
 			issue.NoSec = ignored
@@ -688,15 +1203,53 @@ func (gosec *Analyzer) updateIssues(issue *issue.Issue) {
 		}
 		if ignored && gosec.trackSuppressions {
 			issue.WithSuppressions(suppressions)
-			gosec.issues = append(gosec.issues, issue)
+			gosec.report(issue)
 		} else if !ignored || gosec.showIgnored || gosec.ignoreNosec {
-			gosec.issues = append(gosec.issues, issue)
+			gosec.report(issue)
 		}
 	}
 }
 
+// report passes a discovered issue to the issue handler and retains it
+// unless the analyzer was configured otherwise
+func (gosec *Analyzer) report(issue *issue.Issue) {
+	if gosec.issueHandler != nil {
+		gosec.issueHandler(issue)
+	}
+	if gosec.retainIssues {
+		gosec.issues = append(gosec.issues, issue)
+	}
+}
+
+// SetIssueHandler registers a handler which is invoked as each issue is discovered,
+// with the same issues which are returned by Report. The handler is never invoked
+// concurrently. When retainIssues is false, the issues are only passed to the handler
+// and they are not returned by Report, which keeps the memory usage low on large scans.
+func (gosec *Analyzer) SetIssueHandler(handler IssueHandler, retainIssues bool) {
+	gosec.issueHandler = handler
+	gosec.retainIssues = retainIssues || handler == nil
+}
+
+// SetRuleProfiling enables the measure of the time spent in each rule, which is
+// reported in the RuleTimings of the metrics
+func (gosec *Analyzer) SetRuleProfiling(enabled bool) {
+	gosec.profileRules = enabled
+}
+
+// SetMaxFileSize skips the files larger than the given size in bytes, a size of zero
+// or less analyzes all the files
+func (gosec *Analyzer) SetMaxFileSize(size int64) {
+	gosec.maxFileSize = size
+}
+
 // Report returns the current issues discovered and the metrics about the scan
 func (gosec *Analyzer) Report() ([]*issue.Issue, *Metrics, map[string][]Error) {
+	if gosec.profileRules {
+		// The rules which were never invoked are reported as well
+		for id := range gosec.ruleBuilders {
+			gosec.stats.addRuleTiming(id, 0, 0)
+		}
+	}
 	return gosec.issues, gosec.stats, gosec.errors
 }
 
@@ -706,4 +1259,7 @@ func (gosec *Analyzer) Reset() {
 	gosec.issues = make([]*issue.Issue, 0, 16)
 	gosec.stats = &Metrics{}
 	gosec.ruleset = NewRuleSet()
+	gosec.ruleBuilders = make(map[string]RuleBuilder)
+	gosec.disabledRules = nil
+	gosec.fuzzTargets = nil
 }
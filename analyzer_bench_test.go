@@ -0,0 +1,54 @@
+package gosec_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/rules"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+// benchmarkPackages writes a package on disk for the first sample of each rule
+func benchmarkPackages(b *testing.B) []string {
+	samples := [][]testutils.CodeSample{
+		testutils.SampleCodeG101, testutils.SampleCodeG102, testutils.SampleCodeG104,
+		testutils.SampleCodeG107, testutils.SampleCodeG201, testutils.SampleCodeG204,
+		testutils.SampleCodeG304, testutils.SampleCodeG401, testutils.SampleCodeG402,
+		testutils.SampleCodeG404, testutils.SampleCodeG501, testutils.SampleCodeG601,
+	}
+	var paths []string
+	for _, sample := range samples {
+		pkg := testutils.NewTestPackage()
+		b.Cleanup(pkg.Close)
+		for i, code := range sample[0].Code {
+			pkg.AddFile(fmt.Sprintf("sample_%d.go", i), code)
+		}
+		if err := pkg.Build(); err != nil {
+			b.Fatal(err)
+		}
+		paths = append(paths, pkg.Path)
+	}
+	return paths
+}
+
+func benchmarkProcess(b *testing.B, concurrency int) {
+	paths := benchmarkPackages(b)
+	logger, _ := testutils.NewLogger()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		analyzer := gosec.NewAnalyzer(nil, false, false, false, concurrency, logger)
+		analyzer.LoadRules(rules.Generate(false).RulesInfo())
+		if err := analyzer.Process(nil, paths...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkProcessSequential(b *testing.B) {
+	benchmarkProcess(b, 1)
+}
+
+func BenchmarkProcessParallel(b *testing.B) {
+	benchmarkProcess(b, 4)
+}
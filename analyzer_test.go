@@ -15,15 +15,26 @@
 package gosec_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
 	"github.com/securego/gosec/v2/rules"
 	"github.com/securego/gosec/v2/testutils"
 	"golang.org/x/tools/go/packages"
@@ -138,6 +149,209 @@ var _ = Describe("Analyzer", func() {
 			Expect(metrics.NumFiles).To(Equal(2))
 		})
 
+		It("should report the same issues when analyzing multiple Go packages concurrently", func() {
+			samples := [][]testutils.CodeSample{
+				testutils.SampleCodeG101, testutils.SampleCodeG401, testutils.SampleCodeG404,
+				testutils.SampleCodeG501, testutils.SampleCodeG204, testutils.SampleCodeG304,
+			}
+			var paths []string
+			for _, sample := range samples {
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				for i, code := range sample[0].Code {
+					pkg.AddFile(fmt.Sprintf("sample_%d.go", i), code)
+				}
+				Expect(pkg.Build()).ShouldNot(HaveOccurred())
+				paths = append(paths, pkg.Path)
+			}
+
+			analyzer.LoadRules(rules.Generate(false).RulesInfo())
+			err := analyzer.Process(buildTags, paths...)
+			Expect(err).ShouldNot(HaveOccurred())
+			sequentialIssues, sequentialMetrics, _ := analyzer.Report()
+
+			customAnalyzer := gosec.NewAnalyzer(nil, tests, false, false, 4, logger)
+			customAnalyzer.LoadRules(rules.Generate(false).RulesInfo())
+			err = customAnalyzer.Process(buildTags, paths...)
+			Expect(err).ShouldNot(HaveOccurred())
+			concurrentIssues, concurrentMetrics, _ := customAnalyzer.Report()
+
+			Expect(sequentialIssues).ShouldNot(BeEmpty())
+			Expect(concurrentIssues).Should(ConsistOf(sequentialIssues))
+			Expect(concurrentMetrics).Should(Equal(sequentialMetrics))
+		})
+
+		It("should report the same issues for already loaded packages", func() {
+			sample := testutils.SampleCodeG401[0]
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", sample.Code[0])
+			Expect(pkg.Build()).ShouldNot(HaveOccurred())
+
+			analyzer.LoadRules(rules.Generate(false).RulesInfo())
+			err := analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			expectedIssues, expectedMetrics, _ := analyzer.Report()
+
+			customAnalyzer := gosec.NewAnalyzer(nil, tests, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false).RulesInfo())
+			err = customAnalyzer.ProcessPackages(pkg.Pkgs()...)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := customAnalyzer.Report()
+
+			Expect(issues).ShouldNot(BeEmpty())
+			Expect(issues).Should(ConsistOf(expectedIssues))
+			Expect(metrics).Should(Equal(expectedMetrics))
+		})
+
+		It("should be able to analyze packages built in memory", func() {
+			sample := testutils.SampleCodeG401[0]
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "md5.go", sample.Code[0], parser.ParseComments)
+			Expect(err).ShouldNot(HaveOccurred())
+			info := &types.Info{
+				Types:      make(map[ast.Expr]types.TypeAndValue),
+				Defs:       make(map[*ast.Ident]types.Object),
+				Uses:       make(map[*ast.Ident]types.Object),
+				Implicits:  make(map[ast.Node]types.Object),
+				Selections: make(map[*ast.SelectorExpr]*types.Selection),
+				Scopes:     make(map[ast.Node]*types.Scope),
+				Instances:  make(map[*ast.Ident]types.Instance),
+			}
+			conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+			typesPkg, err := conf.Check("main", fset, []*ast.File{file}, info)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			err = analyzer.ProcessPackages(&packages.Package{
+				Name:       "main",
+				PkgPath:    "main",
+				Fset:       fset,
+				Syntax:     []*ast.File{file},
+				Types:      typesPkg,
+				TypesInfo:  info,
+				TypesSizes: types.SizesFor("gc", "amd64"),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(HaveLen(sample.Errors))
+			Expect(issues[0].RuleID).Should(Equal("G401"))
+			Expect(metrics.NumFiles).Should(Equal(1))
+		})
+
+		It("should return an error for already loaded packages with parsing errors", func() {
+			pkg := &packages.Package{
+				Name:   "main",
+				Errors: []packages.Error{{Pos: "file.go:line:2", Msg: "invalid position"}},
+			}
+			err := analyzer.ProcessPackages(pkg)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should invoke the issue handler once per issue in the reported order", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", testutils.SampleCodeG401[0].Code[0])
+			pkg.AddFile("rand.go", testutils.SampleCodeG404[1].Code[0])
+			Expect(pkg.Build()).ShouldNot(HaveOccurred())
+
+			var handled []*issue.Issue
+			analyzer.SetIssueHandler(func(i *issue.Issue) {
+				handled = append(handled, i)
+			}, true)
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401", "G404")).RulesInfo())
+			err := analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := analyzer.Report()
+			Expect(issues).Should(HaveLen(2))
+			Expect(handled).Should(Equal(issues))
+			for _, i := range handled {
+				Expect(i.RuleID).ShouldNot(BeEmpty())
+			}
+		})
+
+		It("should not retain the issues passed to the issue handler when configured", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", testutils.SampleCodeG401[0].Code[0])
+			Expect(pkg.Build()).ShouldNot(HaveOccurred())
+
+			handled := 0
+			analyzer.SetIssueHandler(func(i *issue.Issue) {
+				handled++
+			}, false)
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			err := analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(BeEmpty())
+			Expect(handled).Should(Equal(testutils.SampleCodeG401[0].Errors))
+			Expect(metrics.NumFound).Should(Equal(handled))
+		})
+
+		It("should invoke the issue handler safely when analyzing packages concurrently", func() {
+			var paths []string
+			for _, sample := range []testutils.CodeSample{testutils.SampleCodeG401[0], testutils.SampleCodeG404[1], testutils.SampleCodeG501[0]} {
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				pkg.AddFile("main.go", sample.Code[0])
+				Expect(pkg.Build()).ShouldNot(HaveOccurred())
+				paths = append(paths, pkg.Path)
+			}
+
+			var handled []*issue.Issue
+			customAnalyzer := gosec.NewAnalyzer(nil, tests, false, false, 4, logger)
+			customAnalyzer.SetIssueHandler(func(i *issue.Issue) {
+				handled = append(handled, i)
+			}, true)
+			customAnalyzer.LoadRules(rules.Generate(false).RulesInfo())
+			err := customAnalyzer.Process(buildTags, paths...)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).ShouldNot(BeEmpty())
+			Expect(handled).Should(ConsistOf(issues))
+		})
+
+		It("should abort the analysis when the context is cancelled", func() {
+			var paths []string
+			for i := 0; i < 10; i++ {
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				pkg.AddFile("md5.go", testutils.SampleCodeG401[0].Code[0])
+				Expect(pkg.Build()).ShouldNot(HaveOccurred())
+				paths = append(paths, pkg.Path)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			analyzer.SetIssueHandler(func(i *issue.Issue) {
+				cancel()
+			}, true)
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			err := analyzer.ProcessContext(ctx, buildTags, paths...)
+			Expect(err).Should(HaveOccurred())
+			Expect(errors.Is(err, context.Canceled)).Should(BeTrue())
+			_, metrics, _ := analyzer.Report()
+			Expect(metrics.NumFiles).Should(BeNumerically("<", len(paths)))
+		})
+
+		It("should abort the analysis when the context deadline is exceeded", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", testutils.SampleCodeG401[0].Code[0])
+			Expect(pkg.Build()).ShouldNot(HaveOccurred())
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+			defer cancel()
+			<-ctx.Done()
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			err := analyzer.ProcessContext(ctx, buildTags, pkg.Path)
+			Expect(errors.Is(err, context.DeadlineExceeded)).Should(BeTrue())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).Should(BeEmpty())
+			Expect(metrics.NumFiles).Should(BeZero())
+		})
+
 		It("should find errors when nosec is not in use", func() {
 			sample := testutils.SampleCodeG401[0]
 			source := sample.Code[0]
@@ -1040,6 +1254,478 @@ var _ = Describe("Analyzer", func() {
 			Expect(nosecIssues).Should(BeEmpty())
 		})
 
+		It("should apply the rule overrides to the reported issues", func() {
+			// Rule for MD5 weak crypto usage
+			sample := testutils.SampleCodeG401[0]
+			source := sample.Code[0]
+
+			config := gosec.NewConfig()
+			_, err := config.ReadFrom(strings.NewReader(`{"rule-overrides": {"G401": {"severity": "low", "confidence": "low"}}}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", source)
+			err = pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).ShouldNot(BeEmpty())
+			for _, i := range issues {
+				Expect(i.RuleID).Should(Equal("G401"))
+				Expect(i.Severity).Should(Equal(issue.Low))
+				Expect(i.Confidence).Should(Equal(issue.Low))
+			}
+		})
+
+		It("should skip the files larger than the maximum file size", func() {
+			sample := testutils.SampleCodeG401[0]
+			customAnalyzer := gosec.NewAnalyzer(nil, false, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			customAnalyzer.SetMaxFileSize(int64(len(sample.Code[0]) + 64))
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", sample.Code[0])
+			pkg.AddFile("assets.go", "package main\n\nvar assets = `"+strings.Repeat("x", 4096)+"`\n")
+			Expect(pkg.Build()).To(Succeed())
+			Expect(customAnalyzer.Process(buildTags, pkg.Path)).To(Succeed())
+			issues, metrics, _ := customAnalyzer.Report()
+			Expect(issues).To(HaveLen(sample.Errors))
+			Expect(metrics.NumFiles).To(Equal(1))
+			Expect(metrics.SkippedFiles).To(HaveLen(1))
+			Expect(filepath.Base(metrics.SkippedFiles[0])).To(Equal("assets.go"))
+		})
+
+		It("should type-check the package of the files larger than the maximum file size", func() {
+			source := `
+package main
+
+import (
+	"crypto/md5"
+	"fmt"
+)
+
+func main() {
+	fmt.Printf("%x\n", md5.Sum([]byte(assets)))
+}
+`
+			customAnalyzer := gosec.NewAnalyzer(nil, false, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			customAnalyzer.SetMaxFileSize(int64(len(source) + 64))
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", source)
+			pkg.AddFile("assets.go", "package main\n\nvar assets = `"+strings.Repeat("x", 4096)+"`\n")
+			Expect(pkg.Build()).To(Succeed())
+			Expect(customAnalyzer.Process(buildTags, pkg.Path)).To(Succeed())
+			issues, metrics, errors := customAnalyzer.Report()
+			Expect(errors).To(BeEmpty())
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].RuleID).To(Equal("G401"))
+			Expect(filepath.Base(issues[0].File)).To(Equal("md5.go"))
+			Expect(metrics.NumFiles).To(Equal(1))
+			Expect(metrics.SkippedFiles).To(HaveLen(1))
+			Expect(filepath.Base(metrics.SkippedFiles[0])).To(Equal("assets.go"))
+		})
+
+		It("should not report the issues of the SSA analyzers in the files larger than the maximum file size", func() {
+			source := `
+package main
+
+import "fmt"
+
+func main() {
+	var a int64 = 1 << 40
+	fmt.Println(int32(a))
+}
+`
+			customAnalyzer := gosec.NewAnalyzer(nil, false, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+			customAnalyzer.SetMaxFileSize(int64(len(source) - 1))
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("overflow.go", source)
+			Expect(pkg.Build()).To(Succeed())
+			Expect(customAnalyzer.Process(buildTags, pkg.Path)).To(Succeed())
+			issues, metrics, _ := customAnalyzer.Report()
+			Expect(issues).To(BeEmpty())
+			Expect(metrics.SkippedFiles).To(HaveLen(1))
+
+			customAnalyzer.Reset()
+			customAnalyzer.SetMaxFileSize(0)
+			Expect(customAnalyzer.Process(buildTags, pkg.Path)).To(Succeed())
+			issues, _, _ = customAnalyzer.Report()
+			Expect(issues).To(HaveLen(1))
+			Expect(issues[0].RuleID).To(Equal("G115"))
+		})
+
+		It("should not report the rules excluded in the fuzz targets", func() {
+			source := `
+package main
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func run(name string) {
+	_ = exec.Command("/usr/bin/" + name).Run()
+}
+
+func fuzzed(t *testing.T, name string) {
+	_ = exec.Command("/usr/bin/" + name).Run()
+}
+
+func FuzzCommand(f *testing.F) {
+	f.Fuzz(func(t *testing.T, name string) {
+		_ = exec.Command("/usr/bin/" + name).Run()
+	})
+}
+
+func FuzzNamed(f *testing.F) {
+	f.Fuzz(fuzzed)
+}
+
+func TestRun(t *testing.T) {
+	run("ls")
+}`
+			lines := func(config gosec.Config) []string {
+				customAnalyzer := gosec.NewAnalyzer(config, true, false, false, 1, logger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G204")).RulesInfo())
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				pkg.AddFile("main.go", "package main\n\nfunc main() {}\n")
+				pkg.AddFile("fuzz_test.go", source)
+				Expect(pkg.Build()).To(Succeed())
+				Expect(customAnalyzer.Process(buildTags, pkg.Path)).To(Succeed())
+				issues, _, _ := customAnalyzer.Report()
+				found := []string{}
+				for _, i := range issues {
+					found = append(found, i.Line)
+				}
+				sort.Strings(found)
+				return found
+			}
+
+			Expect(lines(gosec.NewConfig())).To(Equal([]string{"10", "14", "19"}))
+			config := gosec.NewConfig()
+			config.SetGlobal(gosec.ExcludeRulesInFuzz, "G204")
+			Expect(lines(config)).To(Equal([]string{"10"}))
+		})
+
+		It("should not report the rules excluded in the test files", func() {
+			config := gosec.NewConfig()
+			config.SetGlobal(gosec.ExcludeRulesInTests, "G404, G101")
+			customAnalyzer := gosec.NewAnalyzer(config, true, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401", "G404")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("random.go", `
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+func main() {
+	fmt.Println(rand.Int())
+}`)
+			pkg.AddFile("random_test.go", `
+package main
+
+import (
+	"crypto/md5"
+	"math/rand"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	t.Log(rand.Int(), md5.Sum([]byte("fixture")))
+}`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			found := map[string]string{}
+			for _, i := range issues {
+				found[i.RuleID] = filepath.Base(i.File)
+			}
+			Expect(found).Should(Equal(map[string]string{"G404": "random.go", "G401": "random_test.go"}))
+		})
+
+		It("should only pass the namespaced settings to the rule", func() {
+			source := `
+package main
+
+import "fmt"
+
+func main() {
+	sesame := "f62e5bcda4fae4f82370da0c6f20697b8f8447ef"
+	fmt.Println(sesame)
+}`
+			for _, data := range []string{`{"G101": {"pattern": "(?i)sesame"}}`, `{"pattern": "(?i)sesame"}`} {
+				customLogger, logs := testutils.NewLogger()
+				config := gosec.NewConfig()
+				_, err := config.ReadFrom(strings.NewReader(data))
+				Expect(err).ShouldNot(HaveOccurred())
+				customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, customLogger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G101")).RulesInfo())
+
+				pkg := testutils.NewTestPackage()
+				pkg.AddFile("sesame.go", source)
+				err = pkg.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				err = customAnalyzer.Process(buildTags, pkg.Path)
+				pkg.Close()
+				Expect(err).ShouldNot(HaveOccurred())
+				issues, _, _ := customAnalyzer.Report()
+				if strings.HasPrefix(data, `{"pattern"`) {
+					Expect(issues).Should(BeEmpty())
+					Expect(logs.String()).Should(ContainSubstring(`Ignoring the config key "pattern"`))
+				} else {
+					Expect(issues).Should(HaveLen(1))
+					Expect(issues[0].RuleID).Should(Equal("G101"))
+					Expect(logs.String()).ShouldNot(ContainSubstring("Ignoring the config key"))
+				}
+			}
+		})
+
+		It("should log the invalid settings of the rules through the analyzer logger", func() {
+			customLogger, logs := testutils.NewLogger()
+			config := gosec.NewConfig()
+			config.Set("G121", map[string]interface{}{"patterns": map[string]interface{}{"Acme token": "acme_[0-9"}})
+			customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, customLogger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G121")).RulesInfo())
+			Expect(logs.String()).Should(ContainSubstring(`Ignoring the invalid pattern "Acme token" of G121`))
+		})
+
+		It("should log the invalid pattern settings through the analyzer logger", func() {
+			customLogger, logs := testutils.NewLogger()
+			config := gosec.NewConfig()
+			config.Set("G120", map[string]interface{}{"pattern": "(?i)token["})
+			customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, customLogger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G120")).RulesInfo())
+			Expect(logs.String()).Should(ContainSubstring(`Ignoring the invalid "pattern" setting "(?i)token["`))
+		})
+
+		It("should keep the rule scores which are not overridden", func() {
+			// Rule for MD5 weak crypto usage
+			sample := testutils.SampleCodeG401[0]
+			source := sample.Code[0]
+
+			config := gosec.NewConfig()
+			_, err := config.ReadFrom(strings.NewReader(`{"rule-overrides": {"G401": {"severity": "high"}, "G104": {"severity": "low"}}}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", source)
+			err = pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).ShouldNot(BeEmpty())
+			for _, i := range issues {
+				Expect(i.Severity).Should(Equal(issue.High))
+				Expect(i.Confidence).Should(Equal(issue.High))
+			}
+		})
+
+		DescribeTable("should require a justification for the nosec directives when configured",
+			func(annotation string, expectedRuleIDs []string) {
+				// Rule for MD5 weak crypto usage
+				sample := testutils.SampleCodeG401[0]
+				source := sample.Code[0]
+
+				config := gosec.NewConfig()
+				config.SetGlobal(gosec.NoSecRequireReason, "true")
+				customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+				nosecPackage := testutils.NewTestPackage()
+				defer nosecPackage.Close()
+				nosecSource := strings.Replace(source, "h := md5.New()", "h := md5.New() "+annotation, 1)
+				nosecPackage.AddFile("md5.go", nosecSource)
+				err := nosecPackage.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				err = customAnalyzer.Process(buildTags, nosecPackage.Path)
+				Expect(err).ShouldNot(HaveOccurred())
+				issues, _, _ := customAnalyzer.Report()
+				ruleIDs := []string{}
+				for _, i := range issues {
+					ruleIDs = append(ruleIDs, i.RuleID)
+				}
+				Expect(ruleIDs).Should(ConsistOf(expectedRuleIDs))
+			},
+			Entry("bare nosec", "// #nosec", []string{"G401", gosec.NoSecWithoutReasonID}),
+			Entry("nosec with a reason", "// #nosec -- false positive", []string{}),
+			Entry("rule scoped nosec without a reason", "// #nosec G401", []string{"G401", gosec.NoSecWithoutReasonID}),
+			Entry("rule scoped nosec with a reason", "// #nosec G401 -- false positive", []string{}),
+			Entry("nosec for several rules with a reason", "// #nosec G101 G401 -- false positive", []string{}),
+		)
+
+		It("should not require a justification for the nosec directives by default", func() {
+			sample := testutils.SampleCodeG401[0]
+			source := sample.Code[0]
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+			nosecPackage := testutils.NewTestPackage()
+			defer nosecPackage.Close()
+			nosecSource := strings.Replace(source, "h := md5.New()", "h := md5.New() // #nosec G401", 1)
+			nosecPackage.AddFile("md5.go", nosecSource)
+			err := nosecPackage.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, nosecPackage.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			nosecIssues, _, _ := analyzer.Report()
+			Expect(nosecIssues).Should(BeEmpty())
+		})
+
+		DescribeTable("should replace the default nosec tag with a custom tag",
+			func(tag, annotation string, expected int) {
+				// Rule for MD5 weak crypto usage
+				sample := testutils.SampleCodeG401[0]
+				source := sample.Code[0]
+
+				config := gosec.NewConfig()
+				config.SetGlobal(gosec.NoSecCustomTag, tag)
+				customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+				nosecPackage := testutils.NewTestPackage()
+				defer nosecPackage.Close()
+				nosecSource := strings.Replace(source, "h := md5.New()", "h := md5.New() "+annotation, 1)
+				nosecPackage.AddFile("md5.go", nosecSource)
+				err := nosecPackage.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				err = customAnalyzer.Process(buildTags, nosecPackage.Path)
+				Expect(err).ShouldNot(HaveOccurred())
+				nosecIssues, _, _ := customAnalyzer.Report()
+				Expect(nosecIssues).Should(HaveLen(expected))
+			},
+			Entry("custom tag", "falsePositive", "// #falsePositive", 0),
+			Entry("rule scoped custom tag", "falsePositive", "// #falsePositive G401", 0),
+			Entry("custom tag scoped to another rule", "falsePositive", "// #falsePositive G101", 1),
+			Entry("default tag with a custom tag", "falsePositive", "// #nosec", 1),
+			Entry("rule scoped default tag with a custom tag", "falsePositive", "// #nosec G401", 1),
+			Entry("custom tag in the Go directive form", "gosec:ignore", "//gosec:ignore G401 -- false positive", 0),
+			Entry("default tag with a custom tag in the Go directive form", "gosec:ignore", "// #nosec G401", 1),
+		)
+
+		fileDisableSource := `
+package main
+
+import (
+	"crypto/md5"
+	"fmt"
+	"os"
+)
+
+func main() {
+	h := md5.New()
+	os.Remove("file.txt")
+	fmt.Printf("%x", h.Sum(nil))
+}
+`
+
+		DescribeTable("should disable rules for the whole file with a file-level directive",
+			func(header, annotation string, expectedRuleIDs []string) {
+				customAnalyzer := gosec.NewAnalyzer(nil, tests, false, false, 1, logger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104", "G401")).RulesInfo())
+
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				source := header + strings.Replace(fileDisableSource, "h := md5.New()", "h := md5.New() "+annotation, 1)
+				pkg.AddFile("md5.go", source)
+				err := pkg.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				err = customAnalyzer.Process(buildTags, pkg.Path)
+				Expect(err).ShouldNot(HaveOccurred())
+				issues, _, _ := customAnalyzer.Report()
+				ruleIDs := []string{}
+				for _, i := range issues {
+					ruleIDs = append(ruleIDs, i.RuleID)
+				}
+				Expect(ruleIDs).Should(ConsistOf(expectedRuleIDs))
+			},
+			Entry("without directive", "", "", []string{"G104", "G401"}),
+			Entry("all the rules", "//gosec:disable\n", "", []string{}),
+			Entry("a single rule", "//gosec:disable G104\n", "", []string{"G401"}),
+			Entry("several rules with a justification", "//gosec:disable G104,G401 -- generated code\n", "", []string{}),
+			Entry("in the package documentation", "// Package main is a sample.\n//gosec:disable G401\n", "", []string{"G104"}),
+			Entry("another directive", "//gosec:disabled G401\n", "", []string{"G104", "G401"}),
+			Entry("a rule along with an inline nosec", "//gosec:disable G104\n", "// #nosec G401", []string{}),
+			Entry("a rule along with an inline nosec for the same rule", "//gosec:disable G401\n", "// #nosec G401", []string{"G104"}),
+		)
+
+		It("should not disable rules for the whole file with a directive after the package clause", func() {
+			customAnalyzer := gosec.NewAnalyzer(nil, tests, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104", "G401")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			source := "// Package main is a sample.\n" + strings.Replace(fileDisableSource, "func main() {", "//gosec:disable\nfunc main() {", 1)
+			pkg.AddFile("md5.go", source)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).Should(HaveLen(2))
+		})
+
+		DescribeTable("should require a justification for the file-level directive when configured",
+			func(header string, expectedRuleIDs []string) {
+				config := gosec.NewConfig()
+				config.SetGlobal(gosec.NoSecRequireReason, "true")
+				customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+				customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104", "G401")).RulesInfo())
+
+				pkg := testutils.NewTestPackage()
+				defer pkg.Close()
+				pkg.AddFile("md5.go", header+fileDisableSource)
+				err := pkg.Build()
+				Expect(err).ShouldNot(HaveOccurred())
+				err = customAnalyzer.Process(buildTags, pkg.Path)
+				Expect(err).ShouldNot(HaveOccurred())
+				issues, _, _ := customAnalyzer.Report()
+				ruleIDs := []string{}
+				for _, i := range issues {
+					ruleIDs = append(ruleIDs, i.RuleID)
+				}
+				Expect(ruleIDs).Should(ConsistOf(expectedRuleIDs))
+			},
+			Entry("all the rules without a reason", "//gosec:disable\n", []string{"G104", "G401", gosec.NoSecWithoutReasonID}),
+			Entry("a single rule without a reason", "//gosec:disable G401\n", []string{"G104", "G401", gosec.NoSecWithoutReasonID}),
+			Entry("a single rule with a reason", "//gosec:disable G401 -- generated code\n", []string{"G104"}),
+		)
+
+		It("should ignore the file-level directive when the nosec directives are ignored", func() {
+			config := gosec.NewConfig()
+			config.SetGlobal(gosec.Nosec, "true")
+			customAnalyzer := gosec.NewAnalyzer(config, tests, false, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104", "G401")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", "//gosec:disable\n"+fileDisableSource)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).Should(HaveLen(2))
+		})
+
 		It("should be able to analyze Go test package", func() {
 			customAnalyzer := gosec.NewAnalyzer(nil, true, false, false, 1, logger)
 			customAnalyzer.LoadRules(rules.Generate(false).RulesInfo())
@@ -1151,6 +1837,53 @@ var _ = Describe("Analyzer", func() {
 			issues, _, _ := customAnalyzer.Report()
 			Expect(issues).Should(BeEmpty())
 		})
+		It("should report the issues of the non-generated files only if excluded", func() {
+			customAnalyzer := gosec.NewAnalyzer(nil, false, true, false, 1, logger)
+			customAnalyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104")).RulesInfo())
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("generated.go", `// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api.proto
+
+package main
+
+import "os"
+
+func generated() {
+	os.Remove("generated.tmp")
+}
+`)
+			pkg.AddFile("marker_after_package.go", `package main
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+import "os"
+
+func notGenerated() {
+	os.Remove("marker.tmp")
+}
+`)
+			pkg.AddFile("main.go", `package main
+
+import "os"
+
+func main() {
+	generated()
+	notGenerated()
+	os.Remove("main.tmp")
+}
+`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = customAnalyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, _, _ := customAnalyzer.Report()
+			Expect(issues).Should(HaveLen(2))
+			for _, i := range issues {
+				Expect(i.RuleID).Should(Equal("G104"))
+				Expect(filepath.Base(i.File)).ShouldNot(Equal("generated.go"))
+			}
+		})
 	})
 	It("should be able to analyze Cgo files", func() {
 		analyzer.LoadRules(rules.Generate(false).RulesInfo())
@@ -1168,6 +1901,84 @@ var _ = Describe("Analyzer", func() {
 		Expect(issues).Should(BeEmpty())
 	})
 
+	It("should report the source of a tainted value as a related location", func() {
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G204")).RulesInfo())
+		sample := testutils.SampleCodeG204[2]
+		source := sample.Code[0]
+
+		testPackage := testutils.NewTestPackage()
+		defer testPackage.Close()
+		testPackage.AddFile("main.go", source)
+		err := testPackage.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		err = analyzer.Process(buildTags, testPackage.Path)
+		Expect(err).ShouldNot(HaveOccurred())
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(1))
+		Expect(issues[0].Line).Should(Equal("14"))
+		Expect(issues[0].RelatedLocations).Should(Equal([]issue.Location{{
+			File:    issues[0].File,
+			Line:    "13",
+			Col:     "2",
+			Message: "Source of the tainted value",
+		}}))
+	})
+
+	It("should not suggest a fix replacing a blocklisted import", func() {
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G501")).RulesInfo())
+		sample := testutils.SampleCodeG501[0]
+		source := sample.Code[0]
+
+		testPackage := testutils.NewTestPackage()
+		defer testPackage.Close()
+		testPackage.AddFile("main.go", source)
+		err := testPackage.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		err = analyzer.Process(buildTags, testPackage.Path)
+		Expect(err).ShouldNot(HaveOccurred())
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(1))
+		Expect(issues[0].Autofix).Should(BeNil())
+	})
+
+	It("should suggest a fix restricting the file permissions", func() {
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G302")).RulesInfo())
+		sample := testutils.SampleCodeG302[0]
+		source := sample.Code[0]
+
+		testPackage := testutils.NewTestPackage()
+		defer testPackage.Close()
+		testPackage.AddFile("main.go", source)
+		err := testPackage.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		err = analyzer.Process(buildTags, testPackage.Path)
+		Expect(err).ShouldNot(HaveOccurred())
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(1))
+		Expect(issues[0].Autofix).ShouldNot(BeNil())
+		Expect(issues[0].Autofix.Replacement).Should(Equal("0600"))
+		Expect(issues[0].Autofix.Line).Should(Equal("10"))
+		Expect(issues[0].Autofix.Col).Should(Equal("35"))
+		Expect(issues[0].Autofix.EndCol).Should(Equal("39"))
+	})
+
+	It("should not suggest a fix for rules without a deterministic remediation", func() {
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G204")).RulesInfo())
+		sample := testutils.SampleCodeG204[2]
+		source := sample.Code[0]
+
+		testPackage := testutils.NewTestPackage()
+		defer testPackage.Close()
+		testPackage.AddFile("main.go", source)
+		err := testPackage.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		err = analyzer.Process(buildTags, testPackage.Path)
+		Expect(err).ShouldNot(HaveOccurred())
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(1))
+		Expect(issues[0].Autofix).Should(BeNil())
+	})
+
 	Context("when parsing errors from a package", func() {
 		It("should return no error when the error list is empty", func() {
 			pkg := &packages.Package{}
@@ -1568,5 +2379,25 @@ func main() {
 			Expect(issues[0].Suppressions[0].Kind).To(Equal("inSource"))
 			Expect(issues[0].Suppressions[0].Justification).To(Equal("false positive, this is not a private data"))
 		})
+
+		It("should track the rules disabled for the whole file", func() {
+			sample := testutils.SampleCodeG401[0]
+			source := sample.Code[0]
+			analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G401")).RulesInfo())
+
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("md5.go", "//gosec:disable G401 -- Justification\n"+source)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			err = analyzer.Process(buildTags, pkg.Path)
+			Expect(err).ShouldNot(HaveOccurred())
+			issues, metrics, _ := analyzer.Report()
+			Expect(issues).To(HaveLen(sample.Errors))
+			Expect(issues[0].Suppressions).To(HaveLen(1))
+			Expect(issues[0].Suppressions[0].Kind).To(Equal("inSource"))
+			Expect(issues[0].Suppressions[0].Justification).To(Equal("Justification"))
+			Expect(metrics.NumNosec).To(Equal(1))
+		})
 	})
 })
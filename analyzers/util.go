@@ -43,6 +43,19 @@ func BuildDefaultAnalyzers() []*analysis.Analyzer {
 	}
 }
 
+// defaultScores holds the severity and the confidence of the issues reported by the default analyzers
+var defaultScores = map[string][2]issue.Score{
+	"G115": {issue.High, issue.Medium},
+	"G602": {issue.Low, issue.High},
+}
+
+// DefaultScores returns the severity and the confidence of the issues reported by the
+// default analyzer with the given ID
+func DefaultScores(id string) (issue.Score, issue.Score) {
+	scores := defaultScores[id]
+	return scores[0], scores[1]
+}
+
 // getSSAResult retrieves the SSA result from analysis pass
 func getSSAResult(pass *analysis.Pass) (*SSAAnalyzerResult, error) {
 	result, ok := pass.ResultOf[buildssa.Analyzer]
@@ -0,0 +1,173 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package baseline records a set of known issues and subtracts them from
+// the results of subsequent scans, so that only new issues are reported.
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/securego/gosec/v2/issue"
+)
+
+// Entry is an issue recorded in the baseline
+type Entry struct {
+	RuleID      string `json:"rule_id"`
+	File        string `json:"file"`
+	Line        string `json:"line"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Baseline is the set of issues already known when the baseline was created
+type Baseline struct {
+	Issues []Entry `json:"issues"`
+}
+
+// New creates a baseline from the given issues
+func New(issues []*issue.Issue) *Baseline {
+	b := &Baseline{Issues: []Entry{}}
+	for _, i := range issues {
+		b.Issues = append(b.Issues, Entry{
+			RuleID:      i.RuleID,
+			File:        relativePath(i.File),
+			Line:        i.Line,
+			Fingerprint: Fingerprint(i),
+		})
+	}
+	return b
+}
+
+// Load reads a baseline from the given file
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	b := &Baseline{}
+	if err := json.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("parsing baseline %q: %w", path, err)
+	}
+	return b, nil
+}
+
+// Save writes the baseline into the given file
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Filter returns the issues which are not present in the baseline. Every baseline
+// entry matches at most one issue, so a finding duplicated in the same file is
+// still reported when the baseline contains fewer occurrences of it.
+func (b *Baseline) Filter(issues []*issue.Issue) []*issue.Issue {
+	known := make(map[string]int)
+	for _, e := range b.Issues {
+		known[e.Fingerprint]++
+	}
+	result := []*issue.Issue{}
+	for _, i := range issues {
+		fp := Fingerprint(i)
+		if known[fp] > 0 {
+			known[fp]--
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// Fingerprint computes a stable identifier of the issue from its rule ID, its file
+// and the code of the affected lines. The line numbers are left out, so the
+// fingerprint does not change when the code is only moved around in the file.
+func Fingerprint(i *issue.Issue) string {
+	return FingerprintPath(i, relativePath(i.File))
+}
+
+// FingerprintPath computes the fingerprint of the issue with the given path of its file,
+// such as the path relative to the project root used by the reports of the CI services.
+func FingerprintPath(i *issue.Issue, path string) string {
+	h := sha256.New()
+	h.Write([]byte(i.RuleID))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write([]byte(normalizeCode(i.Code, i.Line)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// relativePath returns the path relative to the working directory, so that the
+// baseline can be shared between checkouts located in different directories.
+func relativePath(path string) string {
+	if !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// normalizeCode keeps only the code of the lines reported by the issue, without the
+// line number prefix added to the snippet and the surrounding whitespace.
+func normalizeCode(code, line string) string {
+	start, end := lineRange(line)
+	var lines []string
+	for _, l := range strings.Split(code, "\n") {
+		prefix, text, found := strings.Cut(l, ": ")
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(prefix)
+		if err != nil {
+			continue
+		}
+		if start > 0 && (n < start || n > end) {
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// lineRange parses the line of an issue which is either a single line or a range
+func lineRange(line string) (int, int) {
+	parts := strings.SplitN(line, "-", 2)
+	start, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0
+	}
+	end := start
+	if len(parts) == 2 {
+		if end, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0
+		}
+	}
+	return start, end
+}
@@ -0,0 +1,119 @@
+package baseline_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2/baseline"
+	"github.com/securego/gosec/v2/issue"
+)
+
+func newIssue(ruleID, file, line, code string) *issue.Issue {
+	return &issue.Issue{
+		RuleID:     ruleID,
+		File:       file,
+		Line:       line,
+		Code:       code,
+		Severity:   issue.High,
+		Confidence: issue.High,
+	}
+}
+
+var _ = Describe("Baseline", func() {
+	var known []*issue.Issue
+
+	BeforeEach(func() {
+		known = []*issue.Issue{
+			newIssue("G101", "main.go", "5", "4: func main() {\n5: \tpassword := \"secret\"\n6: \tfmt.Println(password)\n"),
+			newIssue("G304", "file.go", "10-11", "9: func read(path string) {\n10: \tos.ReadFile(\n11: \t\tpath)\n12: }\n"),
+		}
+	})
+
+	Context("when fingerprinting issues", func() {
+		It("should ignore the line numbers", func() {
+			shifted := newIssue("G101", "main.go", "8", "7: func main() {\n8: \tpassword := \"secret\"\n9: \tfmt.Println(password)\n")
+			Expect(baseline.Fingerprint(shifted)).To(Equal(baseline.Fingerprint(known[0])))
+		})
+
+		It("should ignore the surrounding lines of the snippet", func() {
+			changed := newIssue("G101", "main.go", "5", "4: // a new comment\n5: \tpassword := \"secret\"\n6: \tlog.Println(password)\n")
+			Expect(baseline.Fingerprint(changed)).To(Equal(baseline.Fingerprint(known[0])))
+		})
+
+		It("should not depend on the location of the checkout", func() {
+			wd, err := os.Getwd()
+			Expect(err).ShouldNot(HaveOccurred())
+			absolute := newIssue("G101", filepath.Join(wd, "main.go"), "5", known[0].Code)
+			Expect(baseline.Fingerprint(absolute)).To(Equal(baseline.Fingerprint(known[0])))
+		})
+
+		It("should differ for another rule", func() {
+			other := newIssue("G102", "main.go", "5", known[0].Code)
+			Expect(baseline.Fingerprint(other)).NotTo(Equal(baseline.Fingerprint(known[0])))
+		})
+
+		It("should differ for another file", func() {
+			other := newIssue("G101", "other.go", "5", known[0].Code)
+			Expect(baseline.Fingerprint(other)).NotTo(Equal(baseline.Fingerprint(known[0])))
+		})
+
+		It("should differ when the affected code changes", func() {
+			other := newIssue("G101", "main.go", "5", "4: func main() {\n5: \tpassword := \"another\"\n6: \tfmt.Println(password)\n")
+			Expect(baseline.Fingerprint(other)).NotTo(Equal(baseline.Fingerprint(known[0])))
+		})
+	})
+
+	Context("when filtering issues", func() {
+		It("should suppress the issues shifted by added lines", func() {
+			b := baseline.New(known)
+			issues := []*issue.Issue{
+				newIssue("G101", "main.go", "7", "6: func main() {\n7: \tpassword := \"secret\"\n8: \tfmt.Println(password)\n"),
+				newIssue("G304", "file.go", "20-21", "19: func read(path string) {\n20: \tos.ReadFile(\n21: \t\tpath)\n22: }\n"),
+			}
+			Expect(b.Filter(issues)).To(BeEmpty())
+		})
+
+		It("should not report the findings removed since the baseline", func() {
+			b := baseline.New(known)
+			Expect(b.Filter(known[:1])).To(BeEmpty())
+			Expect(b.Filter([]*issue.Issue{})).To(BeEmpty())
+		})
+
+		It("should report the new findings", func() {
+			b := baseline.New(known)
+			added := newIssue("G204", "exec.go", "3", "2: func run(cmd string) {\n3: \texec.Command(cmd)\n4: }\n")
+			issues := append([]*issue.Issue{added}, known...)
+			Expect(b.Filter(issues)).To(ConsistOf(added))
+		})
+
+		It("should report the new occurrences of a known finding", func() {
+			b := baseline.New(known)
+			duplicate := newIssue("G101", "main.go", "12", "11: \n12: \tpassword := \"secret\"\n13: \n")
+			issues := append([]*issue.Issue{duplicate}, known...)
+			Expect(b.Filter(issues)).To(HaveLen(1))
+		})
+	})
+
+	Context("when persisting the baseline", func() {
+		It("should load the saved baseline", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "baseline.json")
+			b := baseline.New(known)
+			Expect(b.Save(path)).To(Succeed())
+
+			loaded, err := baseline.Load(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(loaded).To(Equal(b))
+			Expect(loaded.Filter(known)).To(BeEmpty())
+		})
+
+		It("should fail to load an invalid baseline", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "baseline.json")
+			Expect(os.WriteFile(path, []byte("{"), 0o600)).To(Succeed())
+			_, err := baseline.Load(path)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
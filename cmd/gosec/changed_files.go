@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/securego/gosec/v2/issue"
+)
+
+// git runs a git command and returns its trimmed output
+func git(args ...string) (string, error) {
+	out, err := exec.Command("git", args...).Output() // #nosec G204
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// changedFiles returns the absolute paths of the Go files which were added or modified
+// between the git reference and the working tree, including the untracked files
+func changedFiles(ref string) (map[string]bool, error) {
+	root, err := git("rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	diff, err := git("diff", "--name-only", "--diff-filter=d", ref, "--", "*.go")
+	if err != nil {
+		return nil, err
+	}
+	// the paths of the untracked files are relative to the current directory unless
+	// listed from the repository root
+	untracked, err := git("-C", root, "ls-files", "--others", "--exclude-standard", "--", "*.go")
+	if err != nil {
+		return nil, err
+	}
+	return parseChangedFiles(root, diff+"\n"+untracked), nil
+}
+
+// parseChangedFiles converts the file names listed by git relative to the repository root
+// into a set of absolute paths
+func parseChangedFiles(root string, diff string) map[string]bool {
+	files := map[string]bool{}
+	for _, name := range strings.Split(diff, "\n") {
+		if name = strings.TrimSpace(name); name != "" {
+			files[resolvePath(filepath.Join(root, filepath.FromSlash(name)))] = true
+		}
+	}
+	return files
+}
+
+// resolvePath returns the absolute path of the file with the symbolic links resolved, so
+// that the paths reported by git and by the analyzer can be compared
+func resolvePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return path
+}
+
+// filterFiles returns the issues found in the given files
+func filterFiles(issues []*issue.Issue, files map[string]bool) []*issue.Issue {
+	result := []*issue.Issue{}
+	for _, i := range issues {
+		if files[resolvePath(i.File)] {
+			result = append(result, i)
+		}
+	}
+	return result
+}
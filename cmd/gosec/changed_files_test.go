@@ -0,0 +1,111 @@
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2/issue"
+)
+
+func issueInFile(ruleID, file string) *issue.Issue {
+	i := createIssue()
+	i.RuleID = ruleID
+	i.File = file
+	i.Code = "1: " + ruleID + " in " + file + "\n"
+	return &i
+}
+
+var _ = Describe("Changed files", func() {
+	var (
+		root   string
+		issues []*issue.Issue
+	)
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		issues = []*issue.Issue{
+			issueInFile("G101", filepath.Join(root, "main.go")),
+			issueInFile("G104", filepath.Join(root, "pkg", "util.go")),
+			issueInFile("G304", filepath.Join(root, "pkg", "util.go")),
+			issueInFile("G401", filepath.Join(root, "cmd", "tool", "tool.go")),
+		}
+	})
+
+	It("should parse the file names of the diff relative to the repository root", func() {
+		changed := parseChangedFiles(root, "main.go\npkg/util.go\n\n")
+		Expect(changed).To(HaveLen(2))
+		Expect(changed).To(HaveKey(filepath.Join(root, "main.go")))
+		Expect(changed).To(HaveKey(filepath.Join(root, "pkg", "util.go")))
+	})
+
+	It("should report only the issues found in the changed files", func() {
+		changed := parseChangedFiles(root, "pkg/util.go\nREADME.go")
+		filtered := filterFiles(issues, changed)
+		Expect(filtered).To(Equal([]*issue.Issue{issues[1], issues[2]}))
+	})
+
+	It("should compare the paths with the symbolic links resolved", func() {
+		Expect(os.MkdirAll(filepath.Join(root, "pkg"), 0o750)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(root, "pkg", "util.go"), []byte("package pkg\n"), 0o600)).To(Succeed())
+		link := filepath.Join(GinkgoT().TempDir(), "link")
+		Expect(os.Symlink(root, link)).To(Succeed())
+
+		changed := parseChangedFiles(link, "pkg/util.go")
+		filtered := filterFiles(issues, changed)
+		Expect(filtered).To(Equal([]*issue.Issue{issues[1], issues[2]}))
+	})
+
+	It("should report no issues when no file changed", func() {
+		Expect(filterFiles(issues, parseChangedFiles(root, ""))).To(BeEmpty())
+	})
+
+	It("should list the untracked files along with the modified files", func() {
+		for _, args := range [][]string{
+			{"init", "-q"},
+			{"config", "user.email", "gosec@example.com"},
+			{"config", "user.name", "gosec"},
+			{"commit", "-q", "--allow-empty", "-m", "initial"},
+		} {
+			_, err := git(append([]string{"-C", root}, args...)...)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(root, "README.md"), []byte("# README\n"), 0o600)).To(Succeed())
+
+		wd, err := os.Getwd()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(os.Chdir(root)).To(Succeed())
+		DeferCleanup(os.Chdir, wd)
+
+		changed, err := changedFiles("HEAD")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changed).To(Equal(map[string]bool{resolvePath(filepath.Join(root, "main.go")): true}))
+	})
+
+	Context("with a baseline", func() {
+		BeforeEach(func() {
+			logger = log.New(io.Discard, "", 0)
+		})
+
+		It("should create the baseline with the issues of all the files", func() {
+			path := filepath.Join(root, "baseline.json")
+			remaining, err := applyBaseline(path, issues)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(filterFiles(remaining, parseChangedFiles(root, "main.go"))).To(BeEmpty())
+
+			newIssues := append(issues, issueInFile("G204", filepath.Join(root, "main.go")), issueInFile("G204", filepath.Join(root, "pkg", "util.go")))
+			remaining, err = applyBaseline(path, newIssues)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining).To(HaveLen(2))
+			filtered := filterFiles(remaining, parseChangedFiles(root, "main.go"))
+			Expect(filtered).To(HaveLen(1))
+			Expect(filtered[0].RuleID).To(Equal("G204"))
+			Expect(filtered[0].File).To(Equal(filepath.Join(root, "main.go")))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Color mode", func() {
+	DescribeTable("should parse the flag value",
+		func(value string, expected colorMode) {
+			var mode colorMode
+			Expect(mode.Set(value)).To(Succeed())
+			Expect(mode).To(Equal(expected))
+		},
+		Entry("auto", "auto", colorMode(colorAuto)),
+		Entry("always", "always", colorMode(colorAlways)),
+		Entry("never", "NEVER", colorMode(colorNever)),
+		Entry("boolean true", "true", colorMode(colorAlways)),
+		Entry("boolean false", "false", colorMode(colorNever)),
+	)
+
+	It("should reject an unknown value", func() {
+		var mode colorMode
+		Expect(mode.Set("sometimes")).NotTo(Succeed())
+	})
+
+	It("should always colorize when forced", func() {
+		Expect(colorMode(colorAlways).enabled(os.Stdout)).To(BeTrue())
+		Expect(colorMode(colorNever).enabled(os.Stdout)).To(BeFalse())
+	})
+
+	It("should not colorize a file which is not a terminal in auto mode", func() {
+		file, err := os.CreateTemp("", "gosec-color")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.Remove(file.Name())
+		defer file.Close()
+		Expect(colorMode(colorAuto).enabled(file)).To(BeFalse())
+	})
+
+	It("should not colorize when NO_COLOR is set in auto mode", func() {
+		tty, err := os.Open("/dev/tty")
+		if err != nil {
+			Skip("no terminal available")
+		}
+		defer tty.Close()
+		old, set := os.LookupEnv("NO_COLOR")
+		os.Setenv("NO_COLOR", "1")
+		defer func() {
+			if set {
+				os.Setenv("NO_COLOR", old)
+			} else {
+				os.Unsetenv("NO_COLOR")
+			}
+		}()
+		Expect(colorMode(colorAuto).enabled(tty)).To(BeFalse())
+	})
+})
@@ -0,0 +1,47 @@
+package main
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+func issueWithSeverity(severity issue.Score) *issue.Issue {
+	i := createIssue()
+	i.Severity = severity
+	return &i
+}
+
+var _ = Describe("Exit code", func() {
+	noErrors := map[string][]gosec.Error{}
+
+	DescribeTable("should fail only when an issue reaches the fail-on severity",
+		func(issues []*issue.Issue, failOn issue.Score, expected int) {
+			Expect(exitCode(issues, noErrors, false, failOn)).To(Equal(expected))
+		},
+		Entry("no issues", []*issue.Issue{}, issue.Low, 0),
+		Entry("low issue with low threshold", []*issue.Issue{issueWithSeverity(issue.Low)}, issue.Low, 1),
+		Entry("low issue with medium threshold", []*issue.Issue{issueWithSeverity(issue.Low)}, issue.Medium, 0),
+		Entry("medium issue with medium threshold", []*issue.Issue{issueWithSeverity(issue.Medium)}, issue.Medium, 1),
+		Entry("medium issue with high threshold", []*issue.Issue{issueWithSeverity(issue.Medium)}, issue.High, 0),
+		Entry("mixed issues with high threshold", []*issue.Issue{issueWithSeverity(issue.Low), issueWithSeverity(issue.High)}, issue.High, 1),
+		Entry("mixed issues below high threshold", []*issue.Issue{issueWithSeverity(issue.Low), issueWithSeverity(issue.Medium)}, issue.High, 0),
+	)
+
+	It("should not count the suppressed issues", func() {
+		suppressed := issueWithSeverity(issue.High)
+		suppressed.WithSuppressions([]issue.SuppressionInfo{{Kind: "inSource", Justification: "false positive"}})
+		Expect(exitCode([]*issue.Issue{suppressed}, noErrors, false, issue.Low)).To(Equal(0))
+	})
+
+	It("should fail on errors regardless of the fail-on severity", func() {
+		errors := map[string][]gosec.Error{"test.go": {*gosec.NewError(1, 1, "build error")}}
+		Expect(exitCode([]*issue.Issue{}, errors, false, issue.High)).To(Equal(1))
+	})
+
+	It("should not fail when no-fail is set", func() {
+		Expect(exitCode([]*issue.Issue{issueWithSeverity(issue.High)}, noErrors, true, issue.Low)).To(Equal(0))
+	})
+})
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// filesFrom reads the list of files to analyze from the given file, or from stdin
+// when the source is "-"
+func filesFrom(source string, stdin io.Reader) ([]string, error) {
+	if source == "-" {
+		return readFileList(stdin)
+	}
+	f, err := os.Open(filepath.Clean(source))
+	if err != nil {
+		return nil, fmt.Errorf("reading the file list: %w", err)
+	}
+	defer f.Close()
+	return readFileList(f)
+}
+
+// readFileList reads newline-separated paths and returns the absolute paths of the
+// Go files without duplicates. Empty lines and files without the .go extension are skipped.
+func readFileList(r io.Reader) ([]string, error) {
+	files := []string{}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || filepath.Ext(name) != ".go" {
+			continue
+		}
+		path, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[path] {
+			seen[path] = true
+			files = append(files, path)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading the file list: %w", err)
+	}
+	return files, nil
+}
+
+// filePackages groups the files into the directories of the packages which need to be loaded
+func filePackages(files []string) []string {
+	packages := []string{}
+	seen := map[string]bool{}
+	for _, file := range files {
+		if dir := filepath.Dir(file); !seen[dir] {
+			seen[dir] = true
+			packages = append(packages, dir)
+		}
+	}
+	return packages
+}
+
+// fileSet converts a list of files into a set
+func fileSet(files []string) map[string]bool {
+	set := make(map[string]bool, len(files))
+	for _, file := range files {
+		set[file] = true
+	}
+	return set
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/rules"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+const uncheckedErrorSource = `
+package main
+
+import "os"
+
+func %s() {
+	os.Remove("file")
+}
+`
+
+var _ = Describe("Files from", func() {
+	var (
+		first  *testutils.TestPackage
+		second *testutils.TestPackage
+	)
+
+	BeforeEach(func() {
+		first = testutils.NewTestPackage()
+		first.AddFile("main.go", fmt.Sprintf(uncheckedErrorSource, "main"))
+		first.AddFile("util.go", fmt.Sprintf(uncheckedErrorSource, "util"))
+		Expect(first.Build()).To(Succeed())
+
+		second = testutils.NewTestPackage()
+		second.AddFile("main.go", fmt.Sprintf(uncheckedErrorSource, "main"))
+		Expect(second.Build()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		first.Close()
+		second.Close()
+	})
+
+	analyze := func(files []string) []*issue.Issue {
+		logger, _ := testutils.NewLogger()
+		analyzer := gosec.NewAnalyzer(gosec.NewConfig(), false, false, false, 1, logger)
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "G104")).RulesInfo())
+		Expect(analyzer.Process(nil, filePackages(files)...)).To(Succeed())
+		issues, _, _ := analyzer.Report()
+		return filterFiles(issues, fileSet(files))
+	}
+
+	It("should read the file list from a file", func() {
+		list := filepath.Join(GinkgoT().TempDir(), "list.txt")
+		content := filepath.Join(first.Path, "util.go") + "\n\n" + filepath.Join(second.Path, "main.go") + "\n"
+		Expect(os.WriteFile(list, []byte(content), 0o600)).To(Succeed())
+
+		files, err := filesFrom(list, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(filePackages(files)).To(Equal([]string{first.Path, second.Path}))
+
+		issues := analyze(files)
+		Expect(issues).To(HaveLen(2))
+		Expect(issues).To(ContainElement(HaveField("File", filepath.Join(first.Path, "util.go"))))
+		Expect(issues).To(ContainElement(HaveField("File", filepath.Join(second.Path, "main.go"))))
+	})
+
+	It("should read the file list from stdin", func() {
+		stdin := strings.NewReader(filepath.Join(first.Path, "main.go") + "\n" + filepath.Join(first.Path, "main.go") + "\n")
+
+		files, err := filesFrom("-", stdin)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(files).To(Equal([]string{filepath.Join(first.Path, "main.go")}))
+
+		issues := analyze(files)
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].File).To(Equal(filepath.Join(first.Path, "main.go")))
+	})
+
+	It("should skip the files which are not Go files", func() {
+		files, err := readFileList(strings.NewReader("README.md\n  \nmain.go\n"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(filepath.Base(files[0])).To(Equal("main.go"))
+	})
+
+	It("should fail when the file list does not exist", func() {
+		_, err := filesFrom(filepath.Join(GinkgoT().TempDir(), "missing.txt"), nil)
+		Expect(err).Should(HaveOccurred())
+	})
+})
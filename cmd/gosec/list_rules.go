@@ -0,0 +1,30 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/securego/gosec/v2/rules"
+)
+
+// printRuleCatalog writes the rules catalog as a JSON array when the format is json,
+// otherwise as a table
+func printRuleCatalog(w io.Writer, catalog []rules.RuleInfo, format string) error {
+	if format == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "\t")
+		return encoder.Encode(catalog)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSeverity\tConfidence\tCWE\tDescription")
+	for _, rule := range catalog {
+		cwe := "-"
+		if rule.Cwe != nil {
+			cwe = rule.Cwe.SprintID()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", rule.ID, rule.Severity, rule.Confidence, cwe, rule.Description)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/analyzers"
+	"github.com/securego/gosec/v2/rules"
+)
+
+var _ = Describe("Rules catalog", func() {
+	ruleList := rules.Generate(false)
+
+	It("should print every rule registered as JSON", func() {
+		buf := &bytes.Buffer{}
+		err := printRuleCatalog(buf, ruleList.Catalog(gosec.NewConfig()), "json")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var catalog []map[string]interface{}
+		Expect(json.Unmarshal(buf.Bytes(), &catalog)).Should(Succeed())
+		Expect(catalog).To(HaveLen(len(ruleList.Rules) + len(analyzers.BuildDefaultAnalyzers())))
+		ids := map[string]bool{}
+		for _, rule := range catalog {
+			ids[rule["id"].(string)] = true
+			Expect(rule).To(HaveKey("description"))
+			Expect(rule).To(HaveKey("severity"))
+			Expect(rule).To(HaveKey("confidence"))
+		}
+		for id := range ruleList.Rules {
+			Expect(ids).To(HaveKey(id))
+		}
+	})
+
+	It("should list the SSA analyzers", func() {
+		buf := &bytes.Buffer{}
+		err := printRuleCatalog(buf, ruleList.Catalog(gosec.NewConfig()), "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buf.String()).To(MatchRegexp(`(?m)^G115\s+HIGH\s+MEDIUM\s+CWE-190\s+Type conversion which leads to integer overflow$`))
+		Expect(buf.String()).To(MatchRegexp(`(?m)^G602\s+LOW\s+HIGH\s+CWE-118\s+Possible slice bounds out of range$`))
+	})
+
+	It("should report the default scores and the CWE of the rules", func() {
+		for _, rule := range ruleList.Catalog(gosec.NewConfig()) {
+			if rule.ID == "G401" {
+				Expect(rule.Severity.String()).To(Equal("MEDIUM"))
+				Expect(rule.Confidence.String()).To(Equal("HIGH"))
+				Expect(rule.Cwe.SprintID()).To(Equal("CWE-328"))
+			}
+		}
+	})
+
+	It("should print the rules as a table", func() {
+		buf := &bytes.Buffer{}
+		err := printRuleCatalog(buf, ruleList.Catalog(gosec.NewConfig()), "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("ID  "))
+		Expect(buf.String()).To(MatchRegexp(`(?m)^G401\s+MEDIUM\s+HIGH\s+CWE-328\s+Detect the usage of MD5 or SHA1$`))
+	})
+})
@@ -15,9 +15,12 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"os"
 	"runtime"
@@ -25,9 +28,12 @@ import (
 	"strings"
 
 	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/analyzers"
+	"github.com/securego/gosec/v2/baseline"
 	"github.com/securego/gosec/v2/cmd/vflag"
 	"github.com/securego/gosec/v2/issue"
 	"github.com/securego/gosec/v2/report"
+	"github.com/securego/gosec/v2/report/text"
 	"github.com/securego/gosec/v2/rules"
 )
 
@@ -51,12 +57,52 @@ USAGE:
 	# json format.
 	$ gosec -fmt=json -out=results.json ./...
 
+	# Write a SARIF report to a file and print the text report to stdout
+	$ gosec -fmt=sarif -out=results.sarif -fmt=text -out=- ./...
+
 	# Run a specific set of rules (by default all rules will be run):
 	$ gosec -include=G101,G203,G401  ./...
 
 	# Run all rules except the provided
 	$ gosec -exclude=G101 $GOPATH/src/github.com/example/project/...
 
+	# Scan the test files without reporting the weak random numbers and the credentials used by the fixtures
+	$ gosec -tests -exclude-rules-in-tests=G101,G404 ./...
+
+	# Scan the test files without reporting the commands and the URLs built from the fuzzed input
+	$ gosec -tests -exclude-rules-in-fuzz=G107,G204 ./...
+
+	# Fail only when issues with a high severity are found
+	$ gosec -fail-on=high ./...
+
+	# Report only the issues which are not present in the baseline file.
+	# The baseline is created with the current issues on the first run.
+	$ gosec -baseline=baseline.json ./...
+
+	# Report only the issues found in the files changed since a git reference
+	$ gosec -changed-since=origin/main ./...
+
+	# Analyze only the Go files listed on stdin
+	$ git ls-files '*.go' | gosec -files-from -
+
+	# Include 3 lines of source code around each issue in the JSON report
+	$ gosec -fmt=json -json-context=3 ./...
+
+	# Add the link to the CWE entry of each issue in the text report
+	$ gosec -show-cwe-links ./...
+
+	# Skip the files larger than 1 MB, such as the embedded assets
+	$ gosec -max-file-size=1048576 ./...
+
+	# Write the file paths relative to the module root in the reports
+	$ gosec -fmt=json -path-style=module ./...
+
+	# Print the catalog of the rules as JSON
+	$ gosec -list-rules -fmt=json
+
+	# Fail on the unknown keys and rule IDs of the config file
+	$ gosec -strict-config -conf config.json ./...
+
 `
 )
 
@@ -71,6 +117,57 @@ func (a *arrayFlags) Set(value string) error {
 	return nil
 }
 
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// colorMode is the value of the color flag. It is also accepted as a boolean flag
+// for backward compatibility, true meaning always and false never.
+type colorMode string
+
+func (c *colorMode) String() string {
+	return string(*c)
+}
+
+func (c *colorMode) Set(value string) error {
+	switch strings.ToLower(value) {
+	case colorAuto:
+		*c = colorAuto
+	case colorAlways, "true":
+		*c = colorAlways
+	case colorNever, "false":
+		*c = colorNever
+	default:
+		return fmt.Errorf("invalid color mode %q, valid options are: auto, always, never", value)
+	}
+	return nil
+}
+
+func (c *colorMode) IsBoolFlag() bool {
+	return true
+}
+
+// enabled checks if the output written in the given file should be colorized. In auto mode the
+// colors are disabled when the NO_COLOR environment variable is set or when the file is not a terminal.
+func (c colorMode) enabled(out *os.File) bool {
+	switch c {
+	case colorAlways:
+		return true
+	case colorNever:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := out.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
 var (
 	// #nosec flag
 	flagIgnoreNoSec = flag.Bool("nosec", false, "Ignores #nosec comments when set")
@@ -78,14 +175,11 @@ var (
 	// show ignored
 	flagShowIgnored = flag.Bool("show-ignored", false, "If enabled, ignored issues are printed")
 
-	// format output
-	flagFormat = flag.String("fmt", "text", "Set output format. Valid options are: json, yaml, csv, junit-xml, html, sonarqube, golint, sarif or text")
+	// tag which replaces #nosec
+	flagNoSecTag = flag.String("nosec-tag", "nosec", "Set the tag recognized instead of #nosec to suppress issues. Some examples: dontanalyze, falsepositive, gosec:ignore")
 
-	// #nosec alternative tag
-	flagAlternativeNoSec = flag.String("nosec-tag", "", "Set an alternative string for #nosec. Some examples: #dontanalyze, #falsepositive")
-
-	// output file
-	flagOutput = flag.String("out", "", "Set output file for results")
+	// #nosec directives require a justification
+	flagNoSecRequireReason = flag.Bool("nosec-require-reason", false, "Ignores the #nosec directives without justification and reports them as issues")
 
 	// config file
 	flagConfig = flag.String("conf", "", "Path to optional config file")
@@ -99,8 +193,14 @@ var (
 	// rules to explicitly exclude
 	flagRulesExclude = vflag.ValidatedFlag{}
 
+	// rules to exclude from the test files
+	flagRulesExcludeInTests = flag.String("exclude-rules-in-tests", "", "Comma separated list of rules IDs whose issues are not reported in the test files")
+
+	// rules to exclude from the fuzz targets
+	flagRulesExcludeInFuzz = flag.String("exclude-rules-in-fuzz", "", "Comma separated list of rules IDs whose issues are not reported in the fuzz targets and in the functions they fuzz")
+
 	// rules to explicitly exclude
-	flagExcludeGenerated = flag.Bool("exclude-generated", false, "Exclude generated files")
+	flagExcludeGenerated = flag.Bool("exclude-generated", false, "Exclude the generated files, which have a \"// Code generated ... DO NOT EDIT.\" comment before the package clause")
 
 	// log to file or stderr
 	flagLogfile = flag.String("log", "", "Log messages to file rather than stderr")
@@ -117,11 +217,14 @@ var (
 	flagConfidence = flag.String("confidence", "low", "Filter out the issues with a lower confidence than the given value. Valid options are: low, medium, high")
 
 	// concurrency value
-	flagConcurrency = flag.Int("concurrency", runtime.NumCPU(), "Concurrency value")
+	flagConcurrency = flag.Int("concurrency", runtime.GOMAXPROCS(0), "Number of packages loaded and analyzed concurrently")
 
 	// do not fail
 	flagNoFail = flag.Bool("no-fail", false, "Do not fail the scanning, even if issues were found")
 
+	// fail the scanning only when issues with a minimum severity are found
+	flagFailOn = flag.String("fail-on", "low", "Fail the scanning only when issues with the given severity or higher are found. Valid options are: low, medium, high")
+
 	// scan tests files
 	flagScanTests = flag.Bool("tests", false, "Scan tests files")
 
@@ -131,14 +234,20 @@ var (
 	// stdout the results as well as write it in the output file
 	flagStdOut = flag.Bool("stdout", false, "Stdout the results as well as write it in the output file")
 
-	// print the text report with color, this is enabled by default
-	flagColor = flag.Bool("color", true, "Prints the text format report with colorization when it goes in the stdout")
+	// print the text report with color, this is enabled by default when the stdout is a terminal
+	flagColor = colorMode(colorAuto)
+
+	// organize the issues of the text report in sections
+	flagGroupBy = flag.String("group-by", "", "Groups the issues of the text format report. Valid options are: severity, file, rule")
+
+	// show the CWE links in the text report
+	flagShowCWELinks = flag.Bool("show-cwe-links", false, "Adds the link to the CWE entry of each issue in the text format report")
 
 	// append ./... to the target dir.
 	flagRecursive = flag.Bool("r", false, "Appends \"./...\" to the target dir.")
 
 	// overrides the output format when stdout the results while saving them in the output file
-	flagVerbose = flag.String("verbose", "", "Overrides the output format when stdout the results while saving them in the output file.\nValid options are: json, yaml, csv, junit-xml, html, sonarqube, golint, sarif or text")
+	flagVerbose = flag.String("verbose", "", "Overrides the output format when stdout the results while saving them in the output file.\nValid options are: json, jsonl, yaml, csv, junit-xml, checkstyle, html, sonarqube, gitlab, codeclimate, golint, tap, sarif or text")
 
 	// output suppression information for auditing purposes
 	flagTrackSuppressions = flag.Bool("track-suppressions", false, "Output suppression information, including its kind and justification")
@@ -146,9 +255,42 @@ var (
 	// flagTerse shows only the summary of scan discarding all the logs
 	flagTerse = flag.Bool("terse", false, "Shows only the results and summary")
 
+	// report only the issues which are not present in the baseline file
+	flagBaseline = flag.String("baseline", "", "Path to a baseline file. It is created with the current issues when missing, otherwise the issues found in it are not reported")
+
+	// report only the issues found in the files changed since a git reference
+	flagChangedSince = flag.String("changed-since", "", "Reports only the issues found in the Go files changed between the git reference and the working tree")
+
+	// read the files to analyze from a file or from stdin
+	flagFilesFrom = flag.String("files-from", "", "Reads the newline-separated list of Go files to analyze from the given file, or from stdin when set to -. The package arguments are ignored")
+
+	// fail on the unknown keys and rule IDs of the config file
+	flagStrictConfig = flag.Bool("strict-config", false, "Fails when the config file contains unknown keys, global options or rule IDs")
+
+	// number of source lines around the issues included in the JSON report
+	flagJSONContext = flag.Int("json-context", 0, "Include in the JSON report the given number of source lines before and after each issue as a context array")
+
+	// write the file paths of the reports as absolute, relative to the working directory or relative to the module root
+	flagPathStyle = flag.String("path-style", "absolute", "Style of the file paths in the reports. Valid options are: absolute, relative, module")
+
+	// print the rules catalog
+	flagListRules = flag.Bool("list-rules", false, "Print the ID, description, default severity and confidence, and CWE of every rule and quit. The catalog is printed as JSON with -fmt=json")
+
+	// skip the files larger than the given size
+	flagMaxFileSize = flag.Int64("max-file-size", 0, "Skip the files larger than the given size in bytes, such as generated asset blobs. A size of 0 analyzes all the files")
+
+	// measure the time spent in each rule
+	flagProfileRules = flag.Bool("profile-rules", false, "Prints the time spent in each rule to stderr after the scan")
+
 	// exclude the folders from scan
 	flagDirsExclude arrayFlags
 
+	// format output
+	flagFormat arrayFlags
+
+	// output file
+	flagOutput arrayFlags
+
 	logger *log.Logger
 )
 
@@ -183,9 +325,25 @@ func loadConfig(configFile string) (gosec.Config, error) {
 			return nil, err
 		}
 		defer file.Close() // #nosec G307
-		if _, err := config.ReadFrom(file); err != nil {
+		data, err := io.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := config.ReadFrom(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+		if err := config.CheckSchemaVersion(); err != nil {
 			return nil, err
 		}
+		if *flagStrictConfig {
+			ruleIDs, err := knownRuleIDs(config)
+			if err != nil {
+				return nil, err
+			}
+			if err := gosec.ValidateConfig(data, ruleIDs); err != nil {
+				return nil, fmt.Errorf("invalid config file %s: %w", configFile, err)
+			}
+		}
 	}
 	if *flagIgnoreNoSec {
 		config.SetGlobal(gosec.Nosec, "true")
@@ -193,8 +351,16 @@ func loadConfig(configFile string) (gosec.Config, error) {
 	if *flagShowIgnored {
 		config.SetGlobal(gosec.ShowIgnored, "true")
 	}
-	if *flagAlternativeNoSec != "" {
-		config.SetGlobal(gosec.NoSecAlternative, *flagAlternativeNoSec)
+	if *flagNoSecTag != "" && *flagNoSecTag != string(gosec.Nosec) {
+		config.SetGlobal(gosec.NoSecCustomTag, *flagNoSecTag)
+	}
+	if tag, err := config.GetGlobal(gosec.NoSecCustomTag); err == nil && tag != "" {
+		if _, err := gosec.NoSecDirective(tag); err != nil {
+			return nil, err
+		}
+	}
+	if *flagNoSecRequireReason {
+		config.SetGlobal(gosec.NoSecRequireReason, "true")
 	}
 	// set global option IncludeRules ,when flag set or global option IncludeRules  is nil
 	if v, _ := config.GetGlobal(gosec.IncludeRules); *flagRulesInclude != "" || v == "" {
@@ -204,10 +370,53 @@ func loadConfig(configFile string) (gosec.Config, error) {
 	if v, _ := config.GetGlobal(gosec.ExcludeRules); flagRulesExclude.String() != "" || v == "" {
 		config.SetGlobal(gosec.ExcludeRules, flagRulesExclude.String())
 	}
+	if *flagRulesExcludeInTests != "" {
+		config.SetGlobal(gosec.ExcludeRulesInTests, *flagRulesExcludeInTests)
+	}
+	if *flagRulesExcludeInFuzz != "" {
+		config.SetGlobal(gosec.ExcludeRulesInFuzz, *flagRulesExcludeInFuzz)
+	}
 	return config, nil
 }
 
-func loadRules(include, exclude string) rules.RuleList {
+// knownRuleIDs returns the IDs of the builtin rules, analyzers and custom rules which
+// can be configured
+func knownRuleIDs(config gosec.Config) (map[string]bool, error) {
+	ids := map[string]bool{}
+	for id := range rules.Generate(false).Rules {
+		ids[id] = true
+	}
+	for _, analyzer := range analyzers.BuildDefaultAnalyzers() {
+		ids[analyzer.Name] = true
+	}
+	customRules, err := config.GetCustomRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range customRules {
+		ids[rule.ID] = true
+	}
+	return ids, nil
+}
+
+// listRules prints the catalog of the rules, including the custom rules of the configuration
+func listRules() error {
+	config, err := loadConfig(*flagConfig)
+	if err != nil {
+		return err
+	}
+	ruleList, err := rules.GenerateWithCustomRules(config, false)
+	if err != nil {
+		return err
+	}
+	var format string
+	if len(flagFormat) > 0 {
+		format = flagFormat[0]
+	}
+	return printRuleCatalog(os.Stdout, ruleList.Catalog(config), format)
+}
+
+func loadRules(config gosec.Config, include, exclude string) (rules.RuleList, error) {
 	var filters []rules.RuleFilter
 	if include != "" {
 		logger.Printf("Including rules: %s", include)
@@ -224,7 +433,7 @@ func loadRules(include, exclude string) rules.RuleList {
 	} else {
 		logger.Println("Excluding rules: default")
 	}
-	return rules.Generate(*flagTrackSuppressions, filters...)
+	return rules.GenerateWithCustomRules(config, *flagTrackSuppressions, filters...)
 }
 
 func getRootPaths(paths []string) []string {
@@ -248,27 +457,44 @@ func getPrintedFormat(format string, verbose string) string {
 	return format
 }
 
-func printReport(format string, color bool, rootPaths []string, reportInfo *gosec.ReportInfo) error {
-	err := report.CreateReport(os.Stdout, format, color, rootPaths, reportInfo)
+// writeReport writes the report in the given format. The text options only apply to the text format.
+func writeReport(w io.Writer, format string, color bool, textOptions text.Options, rootPaths []string, reportInfo *gosec.ReportInfo) error {
+	if format == "text" && textOptions != (text.Options{}) {
+		return text.WriteReportWithOptions(w, reportInfo, color, textOptions)
+	}
+	return report.CreateReport(w, format, color, rootPaths, reportInfo)
+}
+
+func printReport(format string, color bool, textOptions text.Options, rootPaths []string, reportInfo *gosec.ReportInfo) error {
+	err := writeReport(os.Stdout, format, color, textOptions, rootPaths, reportInfo)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func saveReport(filename, format string, rootPaths []string, reportInfo *gosec.ReportInfo) error {
+func saveReport(filename, format string, textOptions text.Options, rootPaths []string, reportInfo *gosec.ReportInfo) error {
 	outfile, err := os.Create(filename) // #nosec G304
 	if err != nil {
 		return err
 	}
 	defer outfile.Close() // #nosec G307
-	err = report.CreateReport(outfile, format, false, rootPaths, reportInfo)
+	err = writeReport(outfile, format, false, textOptions, rootPaths, reportInfo)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func convertToGroupBy(value string) (text.GroupBy, error) {
+	switch groupBy := text.GroupBy(strings.ToLower(value)); groupBy {
+	case text.GroupByNone, text.GroupBySeverity, text.GroupByFile, text.GroupByRule:
+		return groupBy, nil
+	default:
+		return text.GroupByNone, fmt.Errorf("provided value '%s' not valid. Valid options: severity, file, rule", value)
+	}
+}
+
 func convertToScore(value string) (issue.Score, error) {
 	value = strings.ToLower(value)
 	switch value {
@@ -297,17 +523,39 @@ func filterIssues(issues []*issue.Issue, severity issue.Score, confidence issue.
 	return result, trueIssues
 }
 
-func exit(issues []*issue.Issue, errors map[string][]gosec.Error, noFail bool) {
+// applyBaseline creates the baseline file from the issues when it does not exist yet,
+// and returns the issues which are not part of the baseline.
+func applyBaseline(path string, issues []*issue.Issue) ([]*issue.Issue, error) {
+	b, err := baseline.Load(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		b = baseline.New(issues)
+		if err := b.Save(path); err != nil {
+			return nil, err
+		}
+		logger.Printf("Baseline with %d issues written to %s", len(b.Issues), path)
+	} else if err != nil {
+		return nil, err
+	}
+	return b.Filter(issues), nil
+}
+
+// exitCode returns 1 when errors or not suppressed issues with at least
+// the failOn severity were found, unless noFail is set
+func exitCode(issues []*issue.Issue, errors map[string][]gosec.Error, noFail bool, failOn issue.Score) int {
 	nsi := 0
 	for _, issue := range issues {
-		if len(issue.Suppressions) == 0 {
+		if len(issue.Suppressions) == 0 && issue.Severity >= failOn {
 			nsi++
 		}
 	}
 	if (nsi > 0 || len(errors) > 0) && !noFail {
-		os.Exit(1)
+		return 1
 	}
-	os.Exit(0)
+	return 0
+}
+
+func exit(issues []*issue.Issue, errors map[string][]gosec.Error, noFail bool, failOn issue.Score) {
+	os.Exit(exitCode(issues, errors, noFail, failOn))
 }
 
 func main() {
@@ -318,7 +566,9 @@ func main() {
 	flag.Usage = usage
 
 	// Setup the excluded folders from scan
-	flag.Var(&flagDirsExclude, "exclude-dir", "Exclude folder from scan (can be specified multiple times)")
+	flag.Var(&flagFormat, "fmt", "Set output format. Valid options are: json, jsonl, yaml, csv, junit-xml, checkstyle, html, sonarqube, gitlab, codeclimate, golint, tap, sarif or text (default text).\nIt can be specified multiple times, each format being written to the output file given by the -out flag at the same position")
+	flag.Var(&flagOutput, "out", "Set output file for results, - meaning stdout (can be specified multiple times)")
+	flag.Var(&flagDirsExclude, "exclude-dir", "Exclude folder from scan, either by name or with a glob pattern relative to the module root such as **/testdata (can be specified multiple times)")
 	err := flag.Set("exclude-dir", "vendor")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "\nError: failed to exclude the %q directory from scan", "vendor")
@@ -331,6 +581,9 @@ func main() {
 	// set for exclude
 	flag.Var(&flagRulesExclude, "exclude", "Comma separated list of rules IDs to exclude. (see rule list)")
 
+	// set for colorization
+	flag.Var(&flagColor, "color", "Prints the text format report with colorization. Valid options are: auto, always, never")
+
 	// Parse command line arguments
 	flag.Parse()
 
@@ -339,6 +592,14 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *flagListRules {
+		if err := listRules(); err != nil {
+			fmt.Fprintf(os.Stderr, "\nError: %v\n", err) // #nosec
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Ensure at least one file was specified or that the recursive -r flag was set.
 	if flag.NArg() == 0 && !*flagRecursive {
 		fmt.Fprintf(os.Stderr, "\nError: FILE [FILE...] or './...' or -r expected\n") // #nosec
@@ -373,11 +634,35 @@ func main() {
 		logger.Fatalf("Invalid confidence value: %v", err)
 	}
 
+	failOn, err := convertToScore(*flagFailOn)
+	if err != nil {
+		logger.Fatalf("Invalid fail-on value: %v", err)
+	}
+
+	groupBy, err := convertToGroupBy(*flagGroupBy)
+	if err != nil {
+		logger.Fatalf("Invalid group-by value: %v", err)
+	}
+	textOptions := text.Options{GroupBy: groupBy, CWELinks: *flagShowCWELinks}
+
+	pathStyle, err := gosec.ParsePathStyle(*flagPathStyle)
+	if err != nil {
+		logger.Fatalf("Invalid path-style value: %v", err)
+	}
+
+	outputs, err := reportOutputs(flagFormat, flagOutput, *flagStdOut, *flagVerbose)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
 	// Load the analyzer configuration
 	config, err := loadConfig(*flagConfig)
 	if err != nil {
 		logger.Fatal(err)
 	}
+	if _, err := config.GetRuleOverrides(); err != nil {
+		logger.Fatal(err)
+	}
 
 	// Load enabled rule definitions
 	excludeRules, err := config.GetGlobal(gosec.ExcludeRules)
@@ -389,34 +674,65 @@ func main() {
 		logger.Fatal(err)
 	}
 
-	ruleList := loadRules(includeRules, excludeRules)
+	ruleList, err := loadRules(config, includeRules, excludeRules)
+	if err != nil {
+		logger.Fatal(err)
+	}
 	if len(ruleList.Rules) == 0 {
 		logger.Fatal("No rules are configured")
 	}
 
+	// Read the paths and rules ignored by the .gosecignore file of the module
+	ignoreFile, err := gosec.LoadIgnoreFile(".")
+	if err != nil {
+		logger.Fatal(err)
+	}
+
 	// Create the analyzer
 	analyzer := gosec.NewAnalyzer(config, *flagScanTests, *flagExcludeGenerated, *flagTrackSuppressions, *flagConcurrency, logger)
 	analyzer.LoadRules(ruleList.RulesInfo())
+	analyzer.SetRuleProfiling(*flagProfileRules)
+	analyzer.SetMaxFileSize(*flagMaxFileSize)
 
 	excludedDirs := gosec.ExcludedDirsRegExp(flagDirsExclude)
+	excludedGlobs := gosec.ExcludedDirsGlobs(flagDirsExclude)
 	var packages []string
 
-	paths := flag.Args()
-	if len(paths) == 0 {
-		paths = append(paths, "./...")
-	}
-	for _, path := range paths {
-		pcks, err := gosec.PackagePaths(path, excludedDirs)
+	var selected map[string]bool
+	if *flagFilesFrom != "" {
+		files, err := filesFrom(*flagFilesFrom, os.Stdin)
 		if err != nil {
 			logger.Fatal(err)
 		}
-		packages = append(packages, pcks...)
+		selected = fileSet(files)
+		packages = filePackages(files)
+	} else {
+		paths := flag.Args()
+		if len(paths) == 0 {
+			paths = append(paths, "./...")
+		}
+		for _, path := range paths {
+			pcks, err := gosec.PackagePaths(path, excludedDirs, excludedGlobs...)
+			if err != nil {
+				logger.Fatal(err)
+			}
+			packages = append(packages, pcks...)
+		}
 	}
 
 	if len(packages) == 0 {
 		logger.Fatal("No packages found")
 	}
 
+	var changed map[string]bool
+	if *flagChangedSince != "" {
+		changed, err = changedFiles(*flagChangedSince)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		logger.Printf("Reporting only the issues found in %d files changed since %s", len(changed), *flagChangedSince)
+	}
+
 	var buildTags []string
 	if *flagBuildTags != "" {
 		buildTags = strings.Split(*flagBuildTags, ",")
@@ -429,11 +745,39 @@ func main() {
 	// Collect the results
 	issues, metrics, errors := analyzer.Report()
 
+	if *flagProfileRules {
+		if err := printRuleTimings(os.Stderr, metrics.RuleTimings); err != nil {
+			logger.Fatal(err)
+		}
+	}
+
 	// Sort the issue by severity
 	if *flagSortIssues {
 		sortIssues(issues)
 	}
 
+	// Remove the issues ignored by the .gosecignore file
+	issues = ignoreFile.Filter(issues, *flagTrackSuppressions)
+
+	// Remove the issues already present in the baseline
+	if *flagBaseline != "" {
+		issues, err = applyBaseline(*flagBaseline, issues)
+		if err != nil {
+			logger.Fatal(err)
+		}
+	}
+
+	// Keep only the issues found in the changed files. This is done after applying
+	// the baseline, so that a baseline created on the first run covers all the files.
+	if changed != nil {
+		issues = filterFiles(issues, changed)
+	}
+
+	// Keep only the issues found in the files given with -files-from
+	if selected != nil {
+		issues = filterFiles(issues, selected)
+	}
+
 	// Filter the issues by severity and confidence
 	var trueIssues int
 	issues, trueIssues = filterIssues(issues, failSeverity, failConfidence)
@@ -450,15 +794,17 @@ func main() {
 	rootPaths := getRootPaths(flag.Args())
 
 	reportInfo := gosec.NewReportInfo(issues, metrics, errors).WithVersion(Version)
+	if *flagJSONContext > 0 {
+		reportInfo.WithCodeContext(*flagJSONContext)
+	}
 
-	if *flagOutput == "" || *flagStdOut {
-		fileFormat := getPrintedFormat(*flagFormat, *flagVerbose)
-		if err := printReport(fileFormat, *flagColor, rootPaths, reportInfo); err != nil {
-			logger.Fatal(err)
+	styledInfo := reportInfo.WithPathStyle(pathStyle)
+	for _, output := range outputs {
+		info := styledInfo
+		if output.rootRelative() {
+			info = reportInfo
 		}
-	}
-	if *flagOutput != "" {
-		if err := saveReport(*flagOutput, *flagFormat, rootPaths, reportInfo); err != nil {
+		if err := output.write(flagColor.enabled(os.Stdout), textOptions, rootPaths, info); err != nil {
 			logger.Fatal(err)
 		}
 	}
@@ -466,5 +812,5 @@ func main() {
 	// Finalize logging
 	logWriter.Close() // #nosec
 
-	exit(issues, errors, *flagNoFail)
+	exit(issues, errors, *flagNoFail, failOn)
 }
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/report/text"
+)
+
+// stdoutPath is the output path which writes the report to stdout
+const stdoutPath = "-"
+
+// reportOutput is a report format and the path of the file where the report is written
+type reportOutput struct {
+	format string
+	path   string
+}
+
+// rootRelative checks if the format writes the file paths relative to the project root on
+// its own, in which case the path style does not apply
+func (o reportOutput) rootRelative() bool {
+	switch o.format {
+	case "sarif", "sonarqube", "gitlab", "codeclimate":
+		return true
+	}
+	return false
+}
+
+// write writes the report in the output format to the output file or to stdout. The
+// color is only used when the report is written to stdout.
+func (o reportOutput) write(color bool, textOptions text.Options, rootPaths []string, reportInfo *gosec.ReportInfo) error {
+	if o.path == stdoutPath {
+		return printReport(o.format, color, textOptions, rootPaths, reportInfo)
+	}
+	return saveReport(o.path, o.format, textOptions, rootPaths, reportInfo)
+}
+
+// reportOutputs pairs the formats with the output files given in the same order. With a single
+// format, the report is printed to stdout when no output file is given or when the stdout flag
+// is set, in which case the verbose format overrides the printed format.
+func reportOutputs(formats, paths []string, stdout bool, verbose string) ([]reportOutput, error) {
+	if len(formats) == 0 {
+		formats = []string{"text"}
+	}
+	if len(formats) == 1 && len(paths) <= 1 {
+		printed := reportOutput{format: getPrintedFormat(formats[0], verbose), path: stdoutPath}
+		if len(paths) == 0 || paths[0] == stdoutPath {
+			return []reportOutput{printed}, nil
+		}
+		saved := reportOutput{format: formats[0], path: paths[0]}
+		if stdout {
+			return []reportOutput{printed, saved}, nil
+		}
+		return []reportOutput{saved}, nil
+	}
+	if len(formats) != len(paths) {
+		return nil, errors.New("each -fmt flag must be followed by an -out flag when several formats are requested")
+	}
+	outputs := make([]reportOutput, 0, len(formats))
+	stdoutUsed := false
+	for i, format := range formats {
+		if paths[i] == stdoutPath {
+			if stdoutUsed {
+				return nil, errors.New("only one report can be written to stdout")
+			}
+			stdoutUsed = true
+		}
+		outputs = append(outputs, reportOutput{format: format, path: paths[i]})
+	}
+	return outputs, nil
+}
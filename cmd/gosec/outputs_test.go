@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/text"
+)
+
+var _ = Describe("Report outputs", func() {
+	It("should print the text report to stdout by default", func() {
+		outputs, err := reportOutputs(nil, nil, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(outputs).To(Equal([]reportOutput{{format: "text", path: "-"}}))
+	})
+
+	It("should keep the behavior of the stdout and verbose flags with a single format", func() {
+		outputs, err := reportOutputs([]string{"json"}, []string{"results.json"}, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(outputs).To(Equal([]reportOutput{{format: "json", path: "results.json"}}))
+
+		outputs, err = reportOutputs([]string{"json"}, []string{"results.json"}, true, "text")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(outputs).To(Equal([]reportOutput{{format: "text", path: "-"}, {format: "json", path: "results.json"}}))
+	})
+
+	It("should pair the formats with the output files", func() {
+		outputs, err := reportOutputs([]string{"sarif", "text"}, []string{"results.sarif", "-"}, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(outputs).To(Equal([]reportOutput{{format: "sarif", path: "results.sarif"}, {format: "text", path: "-"}}))
+	})
+
+	It("should fail when the formats and the output files are not paired", func() {
+		_, err := reportOutputs([]string{"sarif", "text"}, []string{"results.sarif"}, false, "")
+		Expect(err).Should(HaveOccurred())
+		_, err = reportOutputs([]string{"sarif", "text"}, []string{"-", "-"}, false, "")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("should write each format to its output file", func() {
+		dir := GinkgoT().TempDir()
+		suppressed := issueInFile("G101", filepath.Join(dir, "main.go"))
+		suppressed.Suppressions = []issue.SuppressionInfo{{Kind: "inSource", Justification: "test"}}
+		issues := []*issue.Issue{issueInFile("G104", filepath.Join(dir, "main.go")), suppressed}
+		reportInfo := gosec.NewReportInfo(issues, &gosec.Metrics{NumFound: 1}, map[string][]gosec.Error{})
+
+		textPath := filepath.Join(dir, "results.txt")
+		jsonPath := filepath.Join(dir, "results.json")
+		outputs, err := reportOutputs([]string{"text", "json"}, []string{textPath, jsonPath}, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, output := range outputs {
+			Expect(output.write(false, text.Options{}, []string{dir}, reportInfo)).To(Succeed())
+		}
+
+		content, err := os.ReadFile(textPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("- G104 (CWE-798)"))
+		Expect(string(content)).ToNot(ContainSubstring("- G101"))
+
+		content, err = os.ReadFile(jsonPath)
+		Expect(err).ShouldNot(HaveOccurred())
+		result := struct {
+			Issues []struct {
+				RuleID string `json:"rule_id"`
+			}
+		}{}
+		Expect(json.Unmarshal(content, &result)).To(Succeed())
+		Expect(result.Issues).To(HaveLen(2))
+		Expect(result.Issues[0].RuleID).To(Equal("G104"))
+		Expect(result.Issues[1].RuleID).To(Equal("G101"))
+	})
+
+	It("should apply the path style only to the formats which do not use the project root", func() {
+		Expect(reportOutput{format: "json"}.rootRelative()).To(BeFalse())
+		Expect(reportOutput{format: "text"}.rootRelative()).To(BeFalse())
+		Expect(reportOutput{format: "sarif"}.rootRelative()).To(BeTrue())
+		Expect(reportOutput{format: "sonarqube"}.rootRelative()).To(BeTrue())
+	})
+})
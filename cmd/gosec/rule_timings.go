@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/securego/gosec/v2"
+)
+
+// printRuleTimings writes the time spent in each rule as a table, sorted from the
+// slowest to the fastest rule
+func printRuleTimings(w io.Writer, timings map[string]*gosec.RuleTiming) error {
+	ids := make([]string, 0, len(timings))
+	for id := range timings {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if timings[ids[i]].Duration == timings[ids[j]].Duration {
+			return ids[i] < ids[j]
+		}
+		return timings[ids[i]].Duration > timings[ids[j]].Duration
+	})
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Rule\tTotal time\tInvocations\t")
+	for _, id := range ids {
+		timing := timings[id]
+		fmt.Fprintf(tw, "%s\t%s\t%d\t\n", id, timing.Duration.Round(time.Microsecond), timing.Invocations)
+	}
+	return tw.Flush()
+}
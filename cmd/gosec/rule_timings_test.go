@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/rules"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+var _ = Describe("Rule timings", func() {
+	It("should report every loaded rule with its time and invocations", func() {
+		builders, suppressed := rules.Generate(false).RulesInfo()
+		logger, _ := testutils.NewLogger()
+		analyzer := gosec.NewAnalyzer(gosec.NewConfig(), false, false, false, 2, logger)
+		analyzer.LoadRules(builders, suppressed)
+		analyzer.SetRuleProfiling(true)
+
+		pkg := testutils.NewTestPackage()
+		defer pkg.Close()
+		pkg.AddFile("main.go", `
+package main
+
+import (
+	"crypto/md5"
+	"fmt"
+	"os"
+)
+
+func main() {
+	os.Remove("file")
+	fmt.Println(md5.Sum([]byte("data")))
+}
+`)
+		Expect(pkg.Build()).To(Succeed())
+		Expect(analyzer.Process(nil, pkg.Path)).To(Succeed())
+
+		_, metrics, _ := analyzer.Report()
+		for id := range builders {
+			Expect(metrics.RuleTimings).To(HaveKey(id))
+			Expect(metrics.RuleTimings[id].Duration).To(BeNumerically(">=", 0))
+		}
+		Expect(metrics.RuleTimings["G104"].Invocations).To(BeNumerically(">", 0))
+
+		buf := &bytes.Buffer{}
+		Expect(printRuleTimings(buf, metrics.RuleTimings)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines[0]).To(MatchRegexp(`Rule\s+Total time\s+Invocations`))
+		row := regexp.MustCompile(`^\s*(G\d+)\s+(\S+)\s+(\d+)$`)
+		reported := map[string]bool{}
+		for _, line := range lines[1:] {
+			match := row.FindStringSubmatch(line)
+			Expect(match).NotTo(BeNil(), line)
+			duration, err := time.ParseDuration(match[2])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(duration).To(BeNumerically(">=", 0))
+			reported[match[1]] = true
+		}
+		for id := range builders {
+			Expect(reported).To(HaveKey(id))
+		}
+	})
+
+	It("should sort the rules from the slowest to the fastest", func() {
+		buf := &bytes.Buffer{}
+		Expect(printRuleTimings(buf, map[string]*gosec.RuleTiming{
+			"G101": {Duration: time.Millisecond, Invocations: 10},
+			"G104": {Duration: 3 * time.Millisecond, Invocations: 5},
+			"G401": {Duration: 0, Invocations: 0},
+		})).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		Expect(strings.Fields(lines[1])).To(Equal([]string{"G104", "3ms", "5"}))
+		Expect(strings.Fields(lines[2])).To(Equal([]string{"G101", "1ms", "10"}))
+		Expect(strings.Fields(lines[3])).To(Equal([]string{"G401", "0s", "0"}))
+	})
+})
@@ -5,14 +5,33 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/securego/gosec/v2/issue"
 )
 
 const (
 	// Globals are applicable to all rules and used for general
 	// configuration settings for gosec.
 	Globals = "global"
+	// RuleOverrides is the configuration section which overrides the
+	// severity and the confidence of the issues reported by a rule.
+	RuleOverrides = "rule-overrides"
+	// CustomRules is the configuration section which defines additional rules
+	// reporting the calls to some functions.
+	CustomRules = "custom-rules"
+	// SchemaVersion is the configuration key which holds the version of the
+	// configuration schema.
+	SchemaVersion = "version"
 )
 
+// CurrentSchemaVersion is the version of the configuration schema supported by gosec
+const CurrentSchemaVersion = "1"
+
 // GlobalOption defines the name of the global options
 type GlobalOption string
 
@@ -25,19 +44,69 @@ const (
 	Audit GlobalOption = "audit"
 	// NoSecAlternative global option alternative for #nosec directive
 	NoSecAlternative GlobalOption = "#nosec"
+	// NoSecCustomTag global option which replaces the #nosec directive
+	NoSecCustomTag GlobalOption = "nosec-tag"
+	// NoSecRequireReason global option which requires a justification for the #nosec directives
+	NoSecRequireReason GlobalOption = "nosec-require-reason"
 	// ExcludeRules global option for some rules  should not be load
 	ExcludeRules GlobalOption = "exclude"
 	// IncludeRules global option for  should be load
 	IncludeRules GlobalOption = "include"
 	// SSA global option to enable go analysis framework with SSA support
 	SSA GlobalOption = "ssa"
+	// ExcludeRulesInTests global option listing the rules whose issues are not reported in the test files
+	ExcludeRulesInTests GlobalOption = "exclude-rules-in-tests"
+	// ExcludeRulesInFuzz global option listing the rules whose issues are not reported in the fuzz targets
+	ExcludeRulesInFuzz GlobalOption = "exclude-rules-in-fuzz"
 )
 
+// globalOptions lists the options accepted in the global section of the configuration
+var globalOptions = []GlobalOption{
+	Nosec, ShowIgnored, Audit, NoSecAlternative, NoSecCustomTag,
+	NoSecRequireReason, ExcludeRules, IncludeRules, SSA, ExcludeRulesInTests,
+	ExcludeRulesInFuzz,
+}
+
 // NoSecTag returns the tag used to disable gosec for a line of code.
 func NoSecTag(tag string) string {
 	return fmt.Sprintf("%s%s", "#", tag)
 }
 
+var noSecTagPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*(:[A-Za-z][A-Za-z0-9_-]*)?$`)
+
+// NoSecDirective validates a custom tag and returns the comment prefix used to
+// disable gosec. The tag is prefixed with # unless it uses the Go directive
+// form, e.g. gosec:ignore which is matched as //gosec:ignore.
+func NoSecDirective(tag string) (string, error) {
+	tag = strings.TrimPrefix(tag, "#")
+	if !noSecTagPattern.MatchString(tag) {
+		return "", fmt.Errorf("invalid nosec tag %q: it must be an identifier optionally followed by a colon and another identifier", tag)
+	}
+	if strings.Contains(tag, ":") {
+		return tag, nil
+	}
+	return NoSecTag(tag), nil
+}
+
+// RuleOverride defines the severity and the confidence which replace the ones
+// set by a rule. A nil value keeps the score reported by the rule.
+type RuleOverride struct {
+	Severity   *issue.Score
+	Confidence *issue.Score
+}
+
+// CustomRule defines a rule which reports the calls to a function. The call is the
+// package path followed by the function name, e.g. example.com/internal/legacy.DoThing,
+// or the receiver type followed by the method name, e.g. *example.com/client.Client.Do.
+type CustomRule struct {
+	ID         string
+	Call       string
+	Message    string
+	Severity   issue.Score
+	Confidence issue.Score
+	CWE        string
+}
+
 // Config is used to provide configuration and customization to each of the rules.
 type Config map[string]interface{}
 
@@ -105,6 +174,29 @@ func (c Config) Set(section string, value interface{}) {
 	c[section] = value
 }
 
+// ruleLogger is the key of the logger passed by the analyzer to the rule builders
+const ruleLogger = "rule-logger"
+
+// withLogger returns a copy of the configuration which passes the logger of the analyzer
+// to the rule builders
+func (c Config) withLogger(logger *log.Logger) Config {
+	conf := make(Config, len(c)+1)
+	for key, value := range c {
+		conf[key] = value
+	}
+	conf[ruleLogger] = logger
+	return conf
+}
+
+// Logger returns the logger of the analyzer building the rules, or the standard logger
+// when the rules are built outside of an analyzer
+func (c Config) Logger() *log.Logger {
+	if logger, ok := c[ruleLogger].(*log.Logger); ok && logger != nil {
+		return logger
+	}
+	return log.Default()
+}
+
 // GetGlobal returns value associated with global configuration option
 func (c Config) GetGlobal(option GlobalOption) (string, error) {
 	if globals, ok := c[Globals]; ok {
@@ -135,3 +227,448 @@ func (c Config) IsGlobalEnabled(option GlobalOption) (bool, error) {
 	}
 	return (value == "true" || value == "enabled"), nil
 }
+
+// GetRulesExcludedInTests returns the IDs of the rules whose issues are not reported in
+// the test files, which are configured as a comma separated list
+func (c Config) GetRulesExcludedInTests() map[string]bool {
+	return c.ruleIDs(ExcludeRulesInTests)
+}
+
+// GetRulesExcludedInFuzz returns the IDs of the rules whose issues are not reported in
+// the fuzz targets and in the functions they fuzz, which are configured as a comma separated list
+func (c Config) GetRulesExcludedInFuzz() map[string]bool {
+	return c.ruleIDs(ExcludeRulesInFuzz)
+}
+
+// IsRuleExcluded checks if the rule is left out of the analysis by the include and the
+// exclude global options, so the rule builders can take over the issues of an excluded rule
+func (c Config) IsRuleExcluded(id string) bool {
+	if included := c.ruleIDs(IncludeRules); len(included) > 0 && !included[id] {
+		return true
+	}
+	return c.ruleIDs(ExcludeRules)[id]
+}
+
+// ruleIDs returns the set of rule IDs configured as a comma separated list in the global option
+func (c Config) ruleIDs(option GlobalOption) map[string]bool {
+	rules := map[string]bool{}
+	value, err := c.GetGlobal(option)
+	if err != nil {
+		return rules
+	}
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			rules[id] = true
+		}
+	}
+	return rules
+}
+
+// GetRuleOverrides returns the severity and confidence overrides keyed by rule ID.
+// The overrides are configured as follows:
+//
+//	"rule-overrides": {
+//		"G104": {"severity": "low"},
+//		"G404": {"severity": "high", "confidence": "high"}
+//	}
+func (c Config) GetRuleOverrides() (map[string]RuleOverride, error) {
+	overrides := make(map[string]RuleOverride)
+	section, ok := c[RuleOverrides]
+	if !ok {
+		return overrides, nil
+	}
+	if typed, ok := section.(map[string]RuleOverride); ok {
+		return typed, nil
+	}
+	rules, ok := section.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("section %s has an invalid format", RuleOverrides)
+	}
+	for id, value := range rules {
+		settings, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("override for rule %s has an invalid format", id)
+		}
+		override := RuleOverride{}
+		for key, setting := range settings {
+			score, err := parseScore(setting)
+			if err != nil {
+				return nil, fmt.Errorf("override %s for rule %s: %w", key, id, err)
+			}
+			switch key {
+			case "severity":
+				override.Severity = &score
+			case "confidence":
+				override.Confidence = &score
+			default:
+				return nil, fmt.Errorf("unknown override %s for rule %s", key, id)
+			}
+		}
+		overrides[id] = override
+	}
+	return overrides, nil
+}
+
+// GetCustomRules returns the custom rules defined in the configuration as follows:
+//
+//	"custom-rules": [
+//		{
+//			"id": "C001",
+//			"call": "example.com/internal/legacy.DoThing",
+//			"message": "Use of the deprecated legacy.DoThing",
+//			"severity": "medium",
+//			"confidence": "high",
+//			"cwe": "676"
+//		}
+//	]
+//
+// The ID is made of uppercase letters followed by digits, so that a custom rule can be
+// suppressed by ID with #nosec. The severity and the confidence default to medium and
+// high respectively.
+func (c Config) GetCustomRules() ([]CustomRule, error) {
+	section, ok := c[CustomRules]
+	if !ok {
+		return nil, nil
+	}
+	if typed, ok := section.([]CustomRule); ok {
+		return typed, nil
+	}
+	entries, ok := section.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("section %s has an invalid format", CustomRules)
+	}
+	customRules := make([]CustomRule, 0, len(entries))
+	for i, entry := range entries {
+		settings, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("custom rule %d has an invalid format", i)
+		}
+		rule := CustomRule{Severity: issue.Medium, Confidence: issue.High}
+		for key, setting := range settings {
+			var err error
+			switch key {
+			case "severity":
+				rule.Severity, err = parseScore(setting)
+			case "confidence":
+				rule.Confidence, err = parseScore(setting)
+			case "id":
+				rule.ID, err = parseString(setting)
+			case "call":
+				rule.Call, err = parseString(setting)
+			case "message":
+				rule.Message, err = parseString(setting)
+			case "cwe":
+				rule.CWE, err = parseString(setting)
+				rule.CWE = strings.TrimPrefix(rule.CWE, "CWE-")
+			default:
+				err = fmt.Errorf("unknown setting")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("setting %s of custom rule %d: %w", key, i, err)
+			}
+		}
+		if rule.ID == "" {
+			return nil, fmt.Errorf("custom rule %d has no id", i)
+		}
+		if !ruleIDPattern.MatchString(rule.ID) {
+			return nil, fmt.Errorf("custom rule %d has an invalid id %q, expected uppercase letters followed by digits", i, rule.ID)
+		}
+		if rule.Message == "" {
+			rule.Message = fmt.Sprintf("Call to %s", rule.Call)
+		}
+		customRules = append(customRules, rule)
+	}
+	return customRules, nil
+}
+
+// RuleSettings gives a typed access to the settings of a rule, which are namespaced under
+// the rule ID, e.g. {"G101": {"pattern": "..."}}
+type RuleSettings struct {
+	settings map[string]interface{}
+	config   Config
+}
+
+// RuleSettings returns the settings of the given rule
+func (c Config) RuleSettings(id string) RuleSettings {
+	settings, _ := c[id].(map[string]interface{})
+	return RuleSettings{settings: settings, config: c}
+}
+
+// Get returns the raw value of a setting
+func (s RuleSettings) Get(key string) (interface{}, bool) {
+	value, ok := s.settings[key]
+	return value, ok
+}
+
+// String returns the value of a string setting, or the given default value
+func (s RuleSettings) String(key string, value string) string {
+	if setting, ok := s.Get(key); ok {
+		if str, ok := setting.(string); ok {
+			return str
+		}
+	}
+	return value
+}
+
+// Bool returns the value of a boolean setting, or the given default value
+func (s RuleSettings) Bool(key string, value bool) bool {
+	setting, _ := s.Get(key)
+	switch setting := setting.(type) {
+	case bool:
+		return setting
+	case string:
+		if parsed, err := strconv.ParseBool(setting); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// Int returns the value of an integer setting, or the given default value. The setting can
+// be a number or a string in any base supported by strconv.ParseInt, e.g. "0o600"
+func (s RuleSettings) Int(key string, value int64) int64 {
+	setting, _ := s.Get(key)
+	switch setting := setting.(type) {
+	case int:
+		return int64(setting)
+	case int64:
+		return setting
+	case float64:
+		return int64(setting)
+	case string:
+		if parsed, err := strconv.ParseInt(setting, 0, 64); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// Float returns the value of a floating point setting given as a number or as a string,
+// or the given default value
+func (s RuleSettings) Float(key string, value float64) float64 {
+	setting, _ := s.Get(key)
+	switch setting := setting.(type) {
+	case float64:
+		return setting
+	case int:
+		return float64(setting)
+	case string:
+		if parsed, err := strconv.ParseFloat(setting, 64); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// Strings returns the strings of a list setting, or the given default value when the setting
+// is missing or is not a list
+func (s RuleSettings) Strings(key string, value []string) []string {
+	setting, _ := s.Get(key)
+	switch setting := setting.(type) {
+	case []string:
+		return setting
+	case []interface{}:
+		strs := make([]string, 0, len(setting))
+		for _, item := range setting {
+			if str, ok := item.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+		return strs
+	}
+	return value
+}
+
+// Regexp returns the compiled value of a regular expression setting, or the compiled default
+// value. An invalid regular expression is logged and replaced by the default value.
+func (s RuleSettings) Regexp(key string, value string) *regexp.Regexp {
+	pattern := s.String(key, value)
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		s.config.Logger().Printf("Ignoring the invalid %q setting %q: %s", key, pattern, err)
+		return regexp.MustCompile(value)
+	}
+	return re
+}
+
+// Map returns the value of an object setting
+func (s RuleSettings) Map(key string) (map[string]interface{}, bool) {
+	setting, _ := s.Get(key)
+	m, ok := setting.(map[string]interface{})
+	return m, ok
+}
+
+// isSection checks if the key is one of the top-level sections of the configuration
+func isSection(key string) bool {
+	switch key {
+	case Globals, RuleOverrides, CustomRules, SchemaVersion:
+		return true
+	}
+	return false
+}
+
+// LegacyKeys returns the top-level keys which are neither a section nor a rule ID. These
+// keys are rule settings which are not namespaced under a rule ID, hence ignored by the rules.
+func (c Config) LegacyKeys() []string {
+	var keys []string
+	for key := range c {
+		if !isSection(key) && !ruleIDPattern.MatchString(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CheckSchemaVersion returns an error when the configuration declares a schema version
+// which is not supported. A configuration without version is accepted.
+func (c Config) CheckSchemaVersion() error {
+	value, ok := c[SchemaVersion]
+	if !ok {
+		return nil
+	}
+	if version := fmt.Sprintf("%v", value); version != CurrentSchemaVersion {
+		return fmt.Errorf("unsupported config version '%s', the supported version is %s", version, CurrentSchemaVersion)
+	}
+	return nil
+}
+
+// ValidateConfig checks the raw configuration data against the configuration schema. It
+// returns an error pointing out the line of the first unknown top-level key, unknown global
+// option or rule ID which is not part of the given rules. The rule settings which are not
+// namespaced under a rule ID are unknown top-level keys.
+func ValidateConfig(data []byte, ruleIDs map[string]bool) error {
+	v := &configValidator{data: data, decoder: json.NewDecoder(bytes.NewReader(data)), ruleIDs: ruleIDs}
+	return v.validate()
+}
+
+var ruleIDPattern = regexp.MustCompile(`^[A-Z]+[0-9]+$`)
+
+type configValidator struct {
+	data    []byte
+	decoder *json.Decoder
+	ruleIDs map[string]bool
+}
+
+// line returns the line of the last token read by the decoder
+func (v *configValidator) line() int {
+	offset := v.decoder.InputOffset()
+	if offset > int64(len(v.data)) {
+		offset = int64(len(v.data))
+	}
+	return bytes.Count(v.data[:offset], []byte("\n")) + 1
+}
+
+// keys calls the visitor with each key of the JSON object at the current position. The
+// visitor must consume the value of the key.
+func (v *configValidator) keys(visit func(key string) error) error {
+	if err := v.delim('{'); err != nil {
+		return err
+	}
+	for v.decoder.More() {
+		token, err := v.decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("invalid config key %v near line %d", token, v.line())
+		}
+		if err := visit(key); err != nil {
+			return err
+		}
+	}
+	return v.delim('}')
+}
+
+func (v *configValidator) delim(expected json.Delim) error {
+	token, err := v.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("expected '%s' near line %d", expected, v.line())
+	}
+	return nil
+}
+
+// skip consumes the value at the current position
+func (v *configValidator) skip() error {
+	var value json.RawMessage
+	return v.decoder.Decode(&value)
+}
+
+func (v *configValidator) ruleID(key string, line int) error {
+	if v.ruleIDs[key] {
+		return nil
+	}
+	if ruleIDPattern.MatchString(key) {
+		return fmt.Errorf("unknown rule ID '%s' near line %d", key, line)
+	}
+	return fmt.Errorf("unknown config key '%s' near line %d", key, line)
+}
+
+func (v *configValidator) validate() error {
+	return v.keys(func(key string) error {
+		line := v.line()
+		switch key {
+		case SchemaVersion:
+			var version interface{}
+			if err := v.decoder.Decode(&version); err != nil {
+				return err
+			}
+			if err := (Config{SchemaVersion: version}).CheckSchemaVersion(); err != nil {
+				return fmt.Errorf("%w near line %d", err, line)
+			}
+			return nil
+		case Globals:
+			return v.keys(func(option string) error {
+				known := false
+				for _, globalOption := range globalOptions {
+					known = known || GlobalOption(option) == globalOption
+				}
+				if !known {
+					return fmt.Errorf("unknown global option '%s' near line %d", option, v.line())
+				}
+				return v.skip()
+			})
+		case RuleOverrides:
+			return v.keys(func(id string) error {
+				if err := v.ruleID(id, v.line()); err != nil {
+					return fmt.Errorf("rule override: %w", err)
+				}
+				return v.skip()
+			})
+		case CustomRules:
+			return v.skip()
+		}
+		if err := v.ruleID(key, line); err != nil {
+			return err
+		}
+		return v.skip()
+	})
+}
+
+func parseString(value interface{}) (string, error) {
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("value %v is not a string", value)
+	}
+	return str, nil
+}
+
+func parseScore(value interface{}) (issue.Score, error) {
+	str, ok := value.(string)
+	if !ok {
+		return issue.Low, fmt.Errorf("value %v is not a string", value)
+	}
+	switch strings.ToLower(str) {
+	case "low":
+		return issue.Low, nil
+	case "medium":
+		return issue.Medium, nil
+	case "high":
+		return issue.High, nil
+	}
+	return issue.Low, fmt.Errorf("value '%s' not valid. Valid options: low, medium, high", str)
+}
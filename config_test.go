@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
 )
 
 var _ = Describe("Configuration", func() {
@@ -134,5 +135,272 @@ var _ = Describe("Configuration", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(value).Should(Equal("true"))
 		})
+
+		It("should find the rules left out by the include and exclude options", func() {
+			Expect(configuration.IsRuleExcluded("G206")).Should(BeFalse())
+			configuration.SetGlobal(gosec.ExcludeRules, "G104, G206")
+			Expect(configuration.IsRuleExcluded("G206")).Should(BeTrue())
+			Expect(configuration.IsRuleExcluded("G204")).Should(BeFalse())
+			configuration.SetGlobal(gosec.ExcludeRules, "")
+			configuration.SetGlobal(gosec.IncludeRules, "G204")
+			Expect(configuration.IsRuleExcluded("G206")).Should(BeTrue())
+			Expect(configuration.IsRuleExcluded("G204")).Should(BeFalse())
+		})
+	})
+	Context("when overriding the rules severity and confidence", func() {
+		It("should return no overrides when the section is missing", func() {
+			overrides, err := configuration.GetRuleOverrides()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(overrides).Should(BeEmpty())
+		})
+
+		It("should parse the overrides from file", func() {
+			config := `
+			{
+				"rule-overrides": {
+					"G104": {"severity": "low"},
+					"G404": {"severity": "HIGH", "confidence": "high"}
+				}
+			}`
+			cfg := gosec.NewConfig()
+			_, err := cfg.ReadFrom(strings.NewReader(config))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			overrides, err := cfg.GetRuleOverrides()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(overrides).Should(HaveLen(2))
+			Expect(*overrides["G104"].Severity).Should(Equal(issue.Low))
+			Expect(overrides["G104"].Confidence).Should(BeNil())
+			Expect(*overrides["G404"].Severity).Should(Equal(issue.High))
+			Expect(*overrides["G404"].Confidence).Should(Equal(issue.High))
+		})
+
+		It("should return an error for an invalid score", func() {
+			config := `{"rule-overrides": {"G104": {"severity": "critical"}}}`
+			cfg := gosec.NewConfig()
+			_, err := cfg.ReadFrom(strings.NewReader(config))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = cfg.GetRuleOverrides()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should return an error for an unknown field", func() {
+			config := `{"rule-overrides": {"G104": {"level": "low"}}}`
+			cfg := gosec.NewConfig()
+			_, err := cfg.ReadFrom(strings.NewReader(config))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = cfg.GetRuleOverrides()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	Context("when defining custom rules", func() {
+		It("should return no custom rules when the section is missing", func() {
+			customRules, err := configuration.GetCustomRules()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(customRules).Should(BeEmpty())
+		})
+
+		It("should parse the custom rules from file", func() {
+			config := `
+			{
+				"custom-rules": [
+					{"id": "C001", "call": "example.com/legacy.DoThing", "message": "Deprecated", "severity": "low", "confidence": "medium", "cwe": "CWE-676"},
+					{"id": "C002", "call": "*example.com/client.Client.Do"}
+				]
+			}`
+			cfg := gosec.NewConfig()
+			_, err := cfg.ReadFrom(strings.NewReader(config))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			customRules, err := cfg.GetCustomRules()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(customRules).Should(Equal([]gosec.CustomRule{
+				{ID: "C001", Call: "example.com/legacy.DoThing", Message: "Deprecated", Severity: issue.Low, Confidence: issue.Medium, CWE: "676"},
+				{ID: "C002", Call: "*example.com/client.Client.Do", Message: "Call to *example.com/client.Client.Do", Severity: issue.Medium, Confidence: issue.High},
+			}))
+		})
+
+		DescribeTable("should return an error for an invalid custom rule",
+			func(config string) {
+				cfg := gosec.NewConfig()
+				_, err := cfg.ReadFrom(strings.NewReader(config))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = cfg.GetCustomRules()
+				Expect(err).Should(HaveOccurred())
+			},
+			Entry("not a list", `{"custom-rules": {"id": "C001"}}`),
+			Entry("missing ID", `{"custom-rules": [{"call": "example.com/legacy.DoThing"}]}`),
+			Entry("ID which cannot be suppressed by a #nosec directive", `{"custom-rules": [{"id": "legacy-call", "call": "example.com/legacy.DoThing"}]}`),
+			Entry("invalid score", `{"custom-rules": [{"id": "C001", "call": "example.com/legacy.DoThing", "severity": "critical"}]}`),
+			Entry("unknown field", `{"custom-rules": [{"id": "C001", "call": "example.com/legacy.DoThing", "level": "low"}]}`),
+			Entry("message which is not a string", `{"custom-rules": [{"id": "C001", "call": "example.com/legacy.DoThing", "message": 1}]}`),
+		)
+	})
+
+	Context("when using a custom nosec tag", func() {
+		It("should prefix the tag with #", func() {
+			directive, err := gosec.NoSecDirective("falsePositive")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(directive).Should(Equal("#falsePositive"))
+
+			directive, err = gosec.NoSecDirective("#falsePositive")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(directive).Should(Equal("#falsePositive"))
+		})
+
+		It("should keep the tags in the Go directive form", func() {
+			directive, err := gosec.NoSecDirective("gosec:ignore")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(directive).Should(Equal("gosec:ignore"))
+		})
+
+		It("should reject the tags which are not identifiers", func() {
+			for _, tag := range []string{"", "#", "no sec", "nosec.*", "1nosec", "gosec:", "a:b:c"} {
+				_, err := gosec.NoSecDirective(tag)
+				Expect(err).Should(HaveOccurred(), tag)
+			}
+		})
+	})
+
+	Context("when validating the configuration", func() {
+		ruleIDs := map[string]bool{"G101": true, "G104": true, "G404": true}
+
+		It("should accept a valid configuration", func() {
+			data := `{
+	"version": "1",
+	"global": {"nosec": "enabled", "audit": "enabled"},
+	"rule-overrides": {"G404": {"severity": "low"}},
+	"custom-rules": [{"id": "C001", "call": "example.com/legacy.DoThing"}],
+	"G101": {"pattern": "(?i)passwd|pass|password|pwd|secret|token"},
+	"G104": {"io/ioutil": ["WriteFile"]}
+}`
+			Expect(gosec.ValidateConfig([]byte(data), ruleIDs)).Should(Succeed())
+		})
+
+		It("should report an unknown key with its line", func() {
+			data := `{
+	"global": {"nosec": "enabled"},
+	"severitys": "high"
+}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("unknown config key 'severitys' near line 3"))
+		})
+
+		It("should report the rule settings which are not namespaced under a rule ID", func() {
+			data := `{
+	"G101": {"pattern": "(?i)token"},
+	"pattern": "(?i)secret"
+}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("unknown config key 'pattern' near line 3"))
+		})
+
+		It("should report an unknown rule ID", func() {
+			data := `{
+	"G101": {},
+	"G1O4": {}
+}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("unknown config key 'G1O4' near line 3"))
+
+			data = `{"G999": {}}`
+			err = gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("unknown rule ID 'G999' near line 1"))
+		})
+
+		It("should report an unknown rule ID in the overrides", func() {
+			data := `{
+	"rule-overrides": {
+		"G404": {"severity": "low"},
+		"G405": {"severity": "low"}
+	}
+}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("rule override: unknown rule ID 'G405' near line 4"))
+		})
+
+		It("should report an unknown global option", func() {
+			data := `{"global": {"nosec": "enabled", "audti": "enabled"}}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(MatchError("unknown global option 'audti' near line 1"))
+		})
+
+		It("should reject an unsupported schema version", func() {
+			data := `{"version": "2"}`
+			err := gosec.ValidateConfig([]byte(data), ruleIDs)
+			Expect(err).Should(HaveOccurred())
+
+			_, err = configuration.ReadFrom(strings.NewReader(data))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(configuration.CheckSchemaVersion()).ShouldNot(Succeed())
+		})
+
+		It("should accept a configuration without schema version", func() {
+			Expect(configuration.CheckSchemaVersion()).Should(Succeed())
+			configuration.Set(gosec.SchemaVersion, float64(1))
+			Expect(configuration.CheckSchemaVersion()).Should(Succeed())
+		})
+	})
+
+	Context("when reading the rule settings", func() {
+		It("should read the settings namespaced under the rule ID", func() {
+			data := `{
+	"G101": {"pattern": "(?i)secret", "min_length": "8", "ignore_entropy": true},
+	"G104": {"pattern": "other"}
+}`
+			_, err := configuration.ReadFrom(strings.NewReader(data))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			settings := configuration.RuleSettings("G101")
+			Expect(settings.String("pattern", "default")).Should(Equal("(?i)secret"))
+			Expect(settings.Int("min_length", 0)).Should(Equal(int64(8)))
+			Expect(settings.Bool("ignore_entropy", false)).Should(BeTrue())
+			Expect(configuration.RuleSettings("G104").String("pattern", "default")).Should(Equal("other"))
+			Expect(configuration.RuleSettings("G304").String("pattern", "default")).Should(Equal("default"))
+			Expect(configuration.LegacyKeys()).Should(BeEmpty())
+		})
+
+		It("should parse the typed settings", func() {
+			configuration.Set("G302", map[string]interface{}{
+				"mask":      "0o022",
+				"cost":      float64(10),
+				"threshold": "80.5",
+				"headers":   []interface{}{"X-Frame-Options", "Content-Security-Policy"},
+				"patterns":  map[string]interface{}{"key": "[A-Z]+"},
+			})
+			settings := configuration.RuleSettings("G302")
+			Expect(settings.Int("mask", 0)).Should(Equal(int64(0o022)))
+			Expect(settings.Int("cost", 0)).Should(Equal(int64(10)))
+			Expect(settings.Float("threshold", 0)).Should(Equal(80.5))
+			Expect(settings.Strings("headers", nil)).Should(Equal([]string{"X-Frame-Options", "Content-Security-Policy"}))
+			patterns, ok := settings.Map("patterns")
+			Expect(ok).Should(BeTrue())
+			Expect(patterns).Should(HaveKeyWithValue("key", "[A-Z]+"))
+			Expect(settings.Int("unknown", 3)).Should(Equal(int64(3)))
+		})
+
+		It("should fall back to the default pattern when the configured one is invalid", func() {
+			configuration.Set("G120", map[string]interface{}{"pattern": "(?i)token"})
+			configuration.Set("G130", map[string]interface{}{"pattern": "(unclosed"})
+			Expect(configuration.RuleSettings("G120").Regexp("pattern", "secret").String()).Should(Equal("(?i)token"))
+			Expect(configuration.RuleSettings("G130").Regexp("pattern", "secret").String()).Should(Equal("secret"))
+		})
+
+		It("should not read the settings from the top-level keys", func() {
+			data := `{"pattern": "(?i)token", "enabled": true, "G101": {"min_length": 10}, "global": {"nosec": "enabled"}}`
+			_, err := configuration.ReadFrom(strings.NewReader(data))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			settings := configuration.RuleSettings("G101")
+			Expect(settings.String("pattern", "default")).Should(Equal("default"))
+			Expect(settings.Int("min_length", 0)).Should(Equal(int64(10)))
+			_, ok := settings.Get("global")
+			Expect(ok).Should(BeFalse())
+			Expect(configuration.RuleSettings("G124").Bool("enabled", false)).Should(BeFalse())
+			Expect(configuration.LegacyKeys()).Should(Equal([]string{"enabled", "pattern"}))
+		})
 	})
 })
@@ -18,6 +18,11 @@ const (
 )
 
 var idWeaknesses = map[string]*Weakness{
+	"20": {
+		ID:          "20",
+		Description: "The product receives input or data, but it does not validate or incorrectly validates that the input has the properties that are required to process the data safely and correctly.",
+		Name:        "Improper Input Validation",
+	},
 	"22": {
 		ID:          "22",
 		Description: "The software uses external input to construct a pathname that is intended to identify a file or directory that is located underneath a restricted parent directory, but the software does not properly neutralize special elements within the pathname that can cause the pathname to resolve to a location that is outside of the restricted directory.",
@@ -43,11 +48,26 @@ var idWeaknesses = map[string]*Weakness{
 		Description: "The software constructs all or part of an SQL command using externally-influenced input from an upstream component, but it does not neutralize or incorrectly neutralizes special elements that could modify the intended SQL command when it is sent to a downstream component.",
 		Name:        "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')",
 	},
+	"113": {
+		ID:          "113",
+		Description: "The product receives data from an HTTP agent/component, but it does not neutralize or incorrectly neutralizes CR and LF characters before the data is included in outgoing HTTP headers.",
+		Name:        "Improper Neutralization of CRLF Sequences in HTTP Headers ('HTTP Request/Response Splitting')",
+	},
 	"118": {
 		ID:          "118",
 		Description: "The software does not restrict or incorrectly restricts operations within the boundaries of a resource that is accessed using an index or pointer, such as memory or files.",
 		Name:        "Incorrect Access of Indexable Resource ('Range Error')",
 	},
+	"119": {
+		ID:          "119",
+		Description: "The software performs operations on a memory buffer, but it can read from or write to a memory location that is outside of the intended boundary of the buffer.",
+		Name:        "Improper Restriction of Operations within the Bounds of a Memory Buffer",
+	},
+	"134": {
+		ID:          "134",
+		Description: "The software uses a function that accepts a format string as an argument, but the format string originates from an external source.",
+		Name:        "Use of Externally-Controlled Format String",
+	},
 	"190": {
 		ID:          "190",
 		Description: "The software performs a calculation that can produce an integer overflow or wraparound, when the logic assumes that the resulting value will always be larger than the original value. This can introduce other weaknesses when the calculation is used for resource management or execution control.",
@@ -58,11 +78,31 @@ var idWeaknesses = map[string]*Weakness{
 		Description: "The product exposes sensitive information to an actor that is not explicitly authorized to have access to that information.",
 		Name:        "Exposure of Sensitive Information to an Unauthorized Actor",
 	},
+	"208": {
+		ID:          "208",
+		Description: "Two separate operations in a product require different amounts of time to complete, in a way that is observable to an actor and reveals security-relevant information about the state of the product, such as whether a particular operation was successful or not.",
+		Name:        "Observable Timing Discrepancy",
+	},
+	"214": {
+		ID:          "214",
+		Description: "A process is invoked with sensitive command-line arguments, environment variables, or other elements that can be seen by other processes on the operating system.",
+		Name:        "Invocation of Process Using Visible Sensitive Information",
+	},
 	"242": {
 		ID:          "242",
 		Description: "The program calls a function that can never be guaranteed to work safely.",
 		Name:        "Use of Inherently Dangerous Function",
 	},
+	"248": {
+		ID:          "248",
+		Description: "An exception is thrown from a function, but it is not caught.",
+		Name:        "Uncaught Exception",
+	},
+	"252": {
+		ID:          "252",
+		Description: "The product does not check the return value from a method or function, which can prevent it from detecting unexpected states and conditions.",
+		Name:        "Unchecked Return Value",
+	},
 	"276": {
 		ID:          "276",
 		Description: "During installation, installed file permissions are set to allow anyone to modify those files.",
@@ -73,11 +113,26 @@ var idWeaknesses = map[string]*Weakness{
 		Description: "The software does not validate, or incorrectly validates, a certificate.",
 		Name:        "Improper Certificate Validation",
 	},
+	"297": {
+		ID:          "297",
+		Description: "The software communicates with a host that provides a certificate, but the software does not properly ensure that the certificate is actually associated with that host.",
+		Name:        "Improper Validation of Certificate with Host Mismatch",
+	},
 	"310": {
 		ID:          "310",
 		Description: "Weaknesses in this category are related to the design and implementation of data confidentiality and integrity. Frequently these deal with the use of encoding techniques, encryption libraries, and hashing algorithms. The weaknesses in this category could lead to a degradation of the quality data if they are not addressed.",
 		Name:        "Cryptographic Issues",
 	},
+	"319": {
+		ID:          "319",
+		Description: "The software transmits sensitive or security-critical data in cleartext in a communication channel that can be sniffed by unauthorized actors.",
+		Name:        "Cleartext Transmission of Sensitive Information",
+	},
+	"321": {
+		ID:          "321",
+		Description: "The use of a hard-coded cryptographic key significantly increases the possibility that encrypted data may be recovered.",
+		Name:        "Use of Hard-coded Cryptographic Key",
+	},
 	"322": {
 		ID:          "322",
 		Description: "The software performs a key exchange with an actor without verifying the identity of that actor.",
@@ -98,11 +153,41 @@ var idWeaknesses = map[string]*Weakness{
 		Description: "The product uses an algorithm that produces a digest (output value) that does not meet security expectations for a hash function that allows an adversary to reasonably determine the original input (preimage attack), find another input that can produce the same hash (2nd preimage attack), or find multiple inputs that evaluate to the same hash (birthday attack). ",
 		Name:        "Use of Weak Hash",
 	},
+	"329": {
+		ID:          "329",
+		Description: "The product generates and uses a predictable initialization Vector (IV) with Cipher Block Chaining (CBC) Mode, which causes algorithms to be susceptible to dictionary attacks when they are encrypted under the same key.",
+		Name:        "Generation of Predictable IV with CBC Mode",
+	},
+	"330": {
+		ID:          "330",
+		Description: "The product uses insufficiently random numbers or values in a security context that depends on unpredictable numbers.",
+		Name:        "Use of Insufficiently Random Values",
+	},
+	"335": {
+		ID:          "335",
+		Description: "The software uses a Pseudo-Random Number Generator (PRNG) but does not correctly manage seeds.",
+		Name:        "Incorrect Usage of Seeds in Pseudo-Random Number Generator (PRNG)",
+	},
 	"338": {
 		ID:          "338",
 		Description: "The product uses a Pseudo-Random Number Generator (PRNG) in a security context, but the PRNG's algorithm is not cryptographically strong.",
 		Name:        "Use of Cryptographically Weak Pseudo-Random Number Generator (PRNG)",
 	},
+	"345": {
+		ID:          "345",
+		Description: "The product does not sufficiently verify the origin or authenticity of data, in a way that causes it to accept invalid data.",
+		Name:        "Insufficient Verification of Data Authenticity",
+	},
+	"347": {
+		ID:          "347",
+		Description: "The software does not verify, or incorrectly verifies, the cryptographic signature for data.",
+		Name:        "Improper Verification of Cryptographic Signature",
+	},
+	"367": {
+		ID:          "367",
+		Description: "The product checks the state of a resource before using that resource, but the resource's state can change between the check and the use in a way that invalidates the results of the check.",
+		Name:        "Time-of-check Time-of-use (TOCTOU) Race Condition",
+	},
 	"377": {
 		ID:          "377",
 		Description: "Creating and using insecure temporary files can leave application and system data vulnerable to attack.",
@@ -118,21 +203,96 @@ var idWeaknesses = map[string]*Weakness{
 		Description: "The software does not handle or incorrectly handles a compressed input with a very high compression ratio that produces a large output.",
 		Name:        "Improper Handling of Highly Compressed Data (Data Amplification)",
 	},
+	"426": {
+		ID:          "426",
+		Description: "The product searches for critical resources using an externally-supplied search path that can point to resources that are not under the product's direct control.",
+		Name:        "Untrusted Search Path",
+	},
+	"470": {
+		ID:          "470",
+		Description: "The product uses external input with reflection to select which classes or code to use, but it does not sufficiently prevent the input from selecting improper classes or code.",
+		Name:        "Use of Externally-Controlled Input to Select Classes or Code ('Unsafe Reflection')",
+	},
+	"489": {
+		ID:          "489",
+		Description: "The product is deployed to unauthorized actors with debugging code still enabled or active, which can create unintended entry points or expose sensitive information.",
+		Name:        "Active Debug Code",
+	},
+	"494": {
+		ID:          "494",
+		Description: "The product downloads source code or an executable from a remote location and executes the code without sufficiently verifying the origin and integrity of the code.",
+		Name:        "Download of Code Without Integrity Check",
+	},
+	"502": {
+		ID:          "502",
+		Description: "The application deserializes untrusted data without sufficiently verifying that the resulting data will be valid.",
+		Name:        "Deserialization of Untrusted Data",
+	},
+	"532": {
+		ID:          "532",
+		Description: "Information written to log files can be of a sensitive nature and give valuable guidance to an attacker or expose sensitive user information.",
+		Name:        "Insertion of Sensitive Information into Log File",
+	},
+	"601": {
+		ID:          "601",
+		Description: "A web application accepts a user-controlled input that specifies a link to an external site, and uses that link in a Redirect. This simplifies phishing attacks.",
+		Name:        "URL Redirection to Untrusted Site ('Open Redirect')",
+	},
+	"611": {
+		ID:          "611",
+		Description: "The software processes an XML document that can contain XML entities with URIs that resolve to documents outside of the intended sphere of control, causing the product to embed incorrect documents into its output.",
+		Name:        "Improper Restriction of XML External Entity Reference",
+	},
+	"614": {
+		ID:          "614",
+		Description: "The Secure attribute for sensitive cookies in HTTPS sessions is not set, which could cause the user agent to send those cookies in plaintext over an HTTP session.",
+		Name:        "Sensitive Cookie in HTTPS Session Without 'Secure' Attribute",
+	},
 	"676": {
 		ID:          "676",
 		Description: "The program invokes a potentially dangerous function that could introduce a vulnerability if it is used incorrectly, but the function can also be used safely.",
 		Name:        "Use of Potentially Dangerous Function",
 	},
+	"693": {
+		ID:          "693",
+		Description: "The product does not use or incorrectly uses a protection mechanism that provides sufficient defense against directed attacks against the product.",
+		Name:        "Protection Mechanism Failure",
+	},
 	"703": {
 		ID:          "703",
 		Description: "The software does not properly anticipate or handle exceptional conditions that rarely occur during normal operation of the software.",
 		Name:        "Improper Check or Handling of Exceptional Conditions",
 	},
+	"772": {
+		ID:          "772",
+		Description: "The software does not release a resource after its effective lifetime has ended, i.e., after the resource is no longer needed.",
+		Name:        "Missing Release of Resource after Effective Lifetime",
+	},
 	"798": {
 		ID:          "798",
 		Description: "The software contains hard-coded credentials, such as a password or cryptographic key, which it uses for its own inbound authentication, outbound communication to external components, or encryption of internal data.",
 		Name:        "Use of Hard-coded Credentials",
 	},
+	"916": {
+		ID:          "916",
+		Description: "The software generates a hash for a password, but it uses a scheme that does not provide a sufficient level of computational effort that would make password cracking attacks infeasible or expensive.",
+		Name:        "Use of Password Hash With Insufficient Computational Effort",
+	},
+	"942": {
+		ID:          "942",
+		Description: "The software uses a cross-domain policy file that includes domains that should not be trusted.",
+		Name:        "Permissive Cross-domain Policy with Untrusted Domains",
+	},
+	"1275": {
+		ID:          "1275",
+		Description: "The SameSite attribute for sensitive cookies is not set, or an insecure value is used.",
+		Name:        "Sensitive Cookie with Improper SameSite Attribute",
+	},
+	"1333": {
+		ID:          "1333",
+		Description: "The product uses a regular expression with an inefficient, possibly exponential worst-case computational complexity that consumes excessive CPU cycles.",
+		Name:        "Inefficient Regular Expression Complexity",
+	},
 }
 
 // Get Retrieves a CWE weakness by it's id
@@ -25,6 +25,7 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
+	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -440,21 +441,28 @@ func FindVarIdentities(n *ast.BinaryExpr, c *Context) ([]*ast.Ident, bool) {
 	return nil, false
 }
 
-// PackagePaths returns a slice with all packages path at given root directory
-func PackagePaths(root string, excludes []*regexp.Regexp) ([]string, error) {
+// PackagePaths returns a slice with all packages path at given root directory. The directories
+// matching one of the excluded regexps or one of the glob patterns are not walked. The glob
+// patterns are matched against the path of the directory relative to the module root.
+func PackagePaths(root string, excludes []*regexp.Regexp, globs ...string) ([]string, error) {
 	if strings.HasSuffix(root, "...") {
 		root = root[0 : len(root)-3]
 	} else {
 		return []string{root}, nil
 	}
+	modRoot := ""
+	if len(globs) > 0 {
+		if absRoot, err := filepath.Abs(root); err == nil {
+			modRoot = moduleRoot(absRoot)
+		}
+	}
 	paths := map[string]bool{}
 	err := filepath.Walk(root, func(path string, f os.FileInfo, err error) error {
+		if f != nil && f.IsDir() && (isExcluded(filepath.ToSlash(path), excludes) || isExcludedGlob(path, modRoot, globs)) {
+			return filepath.SkipDir
+		}
 		if filepath.Ext(path) == ".go" {
-			path = filepath.Dir(path)
-			if isExcluded(filepath.ToSlash(path), excludes) {
-				return nil
-			}
-			paths[path] = true
+			paths[filepath.Dir(path)] = true
 		}
 		return nil
 	})
@@ -469,6 +477,65 @@ func PackagePaths(root string, excludes []*regexp.Regexp) ([]string, error) {
 	return result, nil
 }
 
+// moduleRoot returns the closest directory containing a go.mod file, or the
+// directory itself when there is none
+func moduleRoot(dir string) string {
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, "go.mod")); err == nil {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// isExcludedGlob checks if the path relative to the module root matches any of the glob patterns
+func isExcludedGlob(path string, modRoot string, globs []string) bool {
+	if modRoot == "" {
+		return false
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(modRoot, absPath)
+	if err != nil || rel == "." {
+		return false
+	}
+	for _, glob := range globs {
+		if matchGlob(strings.Split(glob, "/"), strings.Split(filepath.ToSlash(rel), "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches the path segments against the glob segments, where ** matches any
+// number of segments
+func matchGlob(pattern []string, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchGlob(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, err := path.Match(pattern[0], segments[0]); err != nil || !matched {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
 // isExcluded checks if a string matches any of the exclusion regexps
 func isExcluded(str string, excludes []*regexp.Regexp) bool {
 	if excludes == nil {
@@ -482,10 +549,19 @@ func isExcluded(str string, excludes []*regexp.Regexp) bool {
 	return false
 }
 
-// ExcludedDirsRegExp builds the regexps for a list of excluded dirs provided as strings
+// isGlob checks if the excluded dir is a glob pattern rather than a directory name
+func isGlob(excludedDir string) bool {
+	return strings.ContainsAny(excludedDir, "*?[")
+}
+
+// ExcludedDirsRegExp builds the regexps for a list of excluded dirs provided as strings.
+// The glob patterns are skipped, see ExcludedDirsGlobs.
 func ExcludedDirsRegExp(excludedDirs []string) []*regexp.Regexp {
 	var exps []*regexp.Regexp
 	for _, excludedDir := range excludedDirs {
+		if isGlob(excludedDir) {
+			continue
+		}
 		str := fmt.Sprintf(`([\\/])?%s([\\/])?`, strings.ReplaceAll(filepath.ToSlash(excludedDir), "/", `\/`))
 		r := regexp.MustCompile(str)
 		exps = append(exps, r)
@@ -493,6 +569,17 @@ func ExcludedDirsRegExp(excludedDirs []string) []*regexp.Regexp {
 	return exps
 }
 
+// ExcludedDirsGlobs returns the excluded dirs which are glob patterns, such as **/testdata
+func ExcludedDirsGlobs(excludedDirs []string) []string {
+	var globs []string
+	for _, excludedDir := range excludedDirs {
+		if isGlob(excludedDir) {
+			globs = append(globs, strings.TrimSuffix(filepath.ToSlash(excludedDir), "/"))
+		}
+	}
+	return globs
+}
+
 // RootPath returns the absolute root path of a scan
 func RootPath(root string) (string, error) {
 	root = strings.TrimSuffix(root, "...")
@@ -61,6 +61,19 @@ var _ = Describe("Helpers", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(paths).Should(Equal([]string{dir}))
 		})
+		It("should exclude the folders matching a glob relative to the module root", func() {
+			Expect(os.WriteFile(dir+"/go.mod", []byte("module example.com/test\n"), 0o600)).To(Succeed())
+			for _, nested := range []string{"/pkg/testdata/fixtures", "/pkg/api", "/internal/gen/proto", "/internal/genx"} {
+				Expect(os.MkdirAll(dir+nested, 0o755)).To(Succeed())
+				Expect(os.WriteFile(dir+nested+"/main.go", []byte("package main\n"), 0o600)).To(Succeed())
+			}
+			paths, err := gosec.PackagePaths(dir+"/pkg/...", nil, "**/testdata")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(paths).Should(ConsistOf(dir + "/pkg/api"))
+			paths, err = gosec.PackagePaths(dir+"/...", nil, "internal/gen", "pkg/*/fixtures")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(paths).Should(ConsistOf(dir, dir+"/pkg/api", dir+"/internal/genx"))
+		})
 		It("should be empty when folder does not exist", func() {
 			nested := dir + "/test"
 			paths, err := gosec.PackagePaths(nested+"/...", nil)
@@ -109,6 +122,12 @@ var _ = Describe("Helpers", func() {
 			Expect(match).Should(BeFalse())
 		})
 
+		It("should separate the glob patterns from the dir names", func() {
+			excluded := []string{"vendor", "**/testdata/", "gen/*.pb"}
+			Expect(gosec.ExcludedDirsRegExp(excluded)).Should(HaveLen(1))
+			Expect(gosec.ExcludedDirsGlobs(excluded)).Should(Equal([]string{"**/testdata", "gen/*.pb"}))
+		})
+
 		It("should create no regexp when dir list is empty", func() {
 			r := gosec.ExcludedDirsRegExp(nil)
 			Expect(r).Should(BeEmpty())
@@ -0,0 +1,140 @@
+package gosec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/securego/gosec/v2/issue"
+)
+
+// IgnoreFileName is the name of the file, at the root of the module, listing the paths
+// and the rules whose issues are not reported
+const IgnoreFileName = ".gosecignore"
+
+// ignoreFileSuppressionKind is the kind of the suppressions recorded for the issues
+// matched by the ignore file
+const ignoreFileSuppressionKind = "ignoreFile"
+
+// IgnoreEntry is a line of the ignore file. It matches the issues of the rule, or of
+// all the rules when the rule ID is empty, found in the files matching the glob.
+type IgnoreEntry struct {
+	Glob   string
+	RuleID string
+	Line   int
+}
+
+// IgnoreFile holds the entries of an ignore file, whose globs are relative to its root
+type IgnoreFile struct {
+	Root    string
+	Entries []IgnoreEntry
+}
+
+// ParseIgnoreFile reads the entries of an ignore file. Each line is either glob:ruleID
+// or glob alone for all the rules. The empty lines and the lines starting with # are
+// skipped. A glob without / matches the files and folders with this name at any depth,
+// and ** matches any number of folders.
+func ParseIgnoreFile(r io.Reader, root string) (*IgnoreFile, error) {
+	f := &IgnoreFile{Root: root}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		entry := IgnoreEntry{Glob: text, Line: line}
+		if i := strings.LastIndex(text, ":"); i >= 0 {
+			entry.Glob, entry.RuleID = strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:])
+			if !ruleIDPattern.MatchString(entry.RuleID) {
+				return nil, fmt.Errorf("%s line %d: invalid rule ID '%s'", IgnoreFileName, line, entry.RuleID)
+			}
+		}
+		entry.Glob = strings.TrimSuffix(filepath.ToSlash(entry.Glob), "/")
+		if entry.Glob == "" {
+			return nil, fmt.Errorf("%s line %d: missing path pattern", IgnoreFileName, line)
+		}
+		if !strings.Contains(entry.Glob, "/") {
+			entry.Glob = "**/" + entry.Glob
+		}
+		entry.Glob = strings.TrimPrefix(entry.Glob, "/")
+		f.Entries = append(f.Entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// LoadIgnoreFile reads the ignore file found at the root of the module containing the
+// directory. It returns nil without error when there is no ignore file.
+func LoadIgnoreFile(dir string) (*IgnoreFile, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	root := moduleRoot(absDir)
+	file, err := os.Open(filepath.Join(root, IgnoreFileName)) // #nosec G304
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() // #nosec G307
+	return ParseIgnoreFile(file, root)
+}
+
+// Match returns the entry matching the issue. An entry matches the files under the
+// folders matching its glob as well.
+func (f *IgnoreFile) Match(i *issue.Issue) (IgnoreEntry, bool) {
+	absPath, err := filepath.Abs(i.File)
+	if err != nil {
+		return IgnoreEntry{}, false
+	}
+	rel, err := filepath.Rel(f.Root, absPath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return IgnoreEntry{}, false
+	}
+	segments := strings.Split(filepath.ToSlash(rel), "/")
+	for _, entry := range f.Entries {
+		if entry.RuleID != "" && entry.RuleID != i.RuleID {
+			continue
+		}
+		pattern := strings.Split(entry.Glob, "/")
+		for end := len(segments); end > 0; end-- {
+			if matchGlob(pattern, segments[:end]) {
+				return entry, true
+			}
+		}
+	}
+	return IgnoreEntry{}, false
+}
+
+// Filter removes the issues matched by the ignore file. When the suppressions are tracked,
+// the matched issues are kept with a suppression recording the matching entry instead.
+func (f *IgnoreFile) Filter(issues []*issue.Issue, trackSuppressions bool) []*issue.Issue {
+	if f == nil {
+		return issues
+	}
+	result := make([]*issue.Issue, 0, len(issues))
+	for _, i := range issues {
+		entry, ok := f.Match(i)
+		if !ok {
+			result = append(result, i)
+			continue
+		}
+		if trackSuppressions {
+			i.WithSuppressions(append(i.Suppressions, issue.SuppressionInfo{
+				Kind:          ignoreFileSuppressionKind,
+				Justification: fmt.Sprintf("Ignored by line %d of %s", entry.Line, IgnoreFileName),
+			}))
+			result = append(result, i)
+		}
+	}
+	return result
+}
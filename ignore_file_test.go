@@ -0,0 +1,97 @@
+package gosec_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+var _ = Describe("Ignore file", func() {
+	const root = "/src/project"
+
+	newIssue := func(file, ruleID string) *issue.Issue {
+		return &issue.Issue{File: filepath.Join(root, file), RuleID: ruleID, Line: "1"}
+	}
+
+	parse := func(content string) *gosec.IgnoreFile {
+		f, err := gosec.ParseIgnoreFile(strings.NewReader(content), root)
+		Expect(err).ShouldNot(HaveOccurred())
+		return f
+	}
+
+	It("should ignore the issues of a rule in the matching paths", func() {
+		f := parse("internal/**/*_mock.go:G101\n")
+		_, ok := f.Match(newIssue("internal/db/store_mock.go", "G101"))
+		Expect(ok).Should(BeTrue())
+		_, ok = f.Match(newIssue("internal/db/store_mock.go", "G404"))
+		Expect(ok).Should(BeFalse())
+		_, ok = f.Match(newIssue("cmd/store_mock.go", "G101"))
+		Expect(ok).Should(BeFalse())
+	})
+
+	It("should ignore the issues of all the rules for a path without rule", func() {
+		f := parse("testdata\n/scripts/gen.go\n")
+		entry, ok := f.Match(newIssue("pkg/parser/testdata/sample.go", "G304"))
+		Expect(ok).Should(BeTrue())
+		Expect(entry.Line).Should(Equal(1))
+		entry, ok = f.Match(newIssue("scripts/gen.go", "G204"))
+		Expect(ok).Should(BeTrue())
+		Expect(entry.Line).Should(Equal(2))
+		_, ok = f.Match(newIssue("tools/scripts/gen.go", "G204"))
+		Expect(ok).Should(BeFalse())
+	})
+
+	It("should skip the comments and the empty lines", func() {
+		f := parse("# generated code\n\n  # vendored code: G101\nvendor\n")
+		Expect(f.Entries).Should(Equal([]gosec.IgnoreEntry{{Glob: "**/vendor", Line: 4}}))
+	})
+
+	It("should reject an invalid rule ID", func() {
+		_, err := gosec.ParseIgnoreFile(strings.NewReader("vendor:g101\n"), root)
+		Expect(err).Should(MatchError(".gosecignore line 1: invalid rule ID 'g101'"))
+	})
+
+	It("should remove the ignored issues", func() {
+		f := parse("*_test.go:G404\n")
+		kept := newIssue("main.go", "G404")
+		issues := f.Filter([]*issue.Issue{newIssue("main_test.go", "G404"), kept}, false)
+		Expect(issues).Should(Equal([]*issue.Issue{kept}))
+	})
+
+	It("should record the ignored issues as suppressed when the suppressions are tracked", func() {
+		f := parse("# fixtures\n*_test.go:G404\n")
+		issues := f.Filter([]*issue.Issue{newIssue("main_test.go", "G404"), newIssue("main.go", "G404")}, true)
+		Expect(issues).Should(HaveLen(2))
+		Expect(issues[0].Suppressions).Should(Equal([]issue.SuppressionInfo{{
+			Kind:          "ignoreFile",
+			Justification: "Ignored by line 2 of .gosecignore",
+		}}))
+		Expect(issues[1].Suppressions).Should(BeEmpty())
+	})
+
+	It("should load the ignore file from the module root", func() {
+		dir, err := os.MkdirTemp("", "gosec")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		Expect(os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/project\n"), 0o600)).Should(Succeed())
+		sub := filepath.Join(dir, "pkg")
+		Expect(os.Mkdir(sub, 0o700)).Should(Succeed())
+
+		f, err := gosec.LoadIgnoreFile(sub)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(f).Should(BeNil())
+
+		Expect(os.WriteFile(filepath.Join(dir, gosec.IgnoreFileName), []byte("pkg:G104\n"), 0o600)).Should(Succeed())
+		f, err = gosec.LoadIgnoreFile(sub)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(f.Root).Should(Equal(dir))
+		_, ok := f.Match(&issue.Issue{File: filepath.Join(sub, "main.go"), RuleID: "G104"})
+		Expect(ok).Should(BeTrue())
+	})
+})
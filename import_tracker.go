@@ -19,7 +19,11 @@ import (
 	"strings"
 )
 
-var versioningPackagePattern = regexp.MustCompile(`v[0-9]+$`)
+var (
+	versioningPackagePattern = regexp.MustCompile(`^v[0-9]+$`)
+	// gopkg.in paths carry the version in the last segment, e.g. gopkg.in/yaml.v3
+	gopkgInPattern = regexp.MustCompile(`^(.+)\.v[0-9]+$`)
+)
 
 // ImportTracker is used to normalize the packages that have been imported
 // by a source file. It is able to differentiate between plain imports, aliased
@@ -74,5 +78,10 @@ func importName(importPath string) string {
 	if len(parts) > 1 && versioningPackagePattern.MatchString(name) {
 		name = parts[len(parts)-2]
 	}
+	if parts[0] == "gopkg.in" {
+		if match := gopkgInPattern.FindStringSubmatch(name); match != nil {
+			name = match[1]
+		}
+	}
 	return name
 }
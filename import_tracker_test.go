@@ -50,5 +50,28 @@ var _ = Describe("Import Tracker", func() {
 			tracker.TrackFile(files[0])
 			Expect(tracker.Imported).Should(Equal(map[string][]string{"fmt": {"fm"}}))
 		})
+		It("should use the package name of the versioned imports", func() {
+			tracker := gosec.NewImportTracker()
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("foo.go", `
+				package foo
+				import (
+				  "github.com/golang-jwt/jwt/v5"
+				  "gopkg.in/yaml.v3"
+				)
+				func foo() {
+				  _, _ = yaml.Marshal(jwt.MapClaims{})
+				}
+			`)
+			err := pkg.Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			pkgs := pkg.Pkgs()
+			Expect(pkgs).Should(HaveLen(1))
+			files := pkgs[0].Syntax
+			Expect(files).Should(HaveLen(1))
+			tracker.TrackFile(files[0])
+			Expect(tracker.Imported).Should(Equal(map[string][]string{"github.com/golang-jwt/jwt/v5": {"jwt"}, "gopkg.in/yaml.v3": {"yaml"}}))
+		})
 	})
 })
@@ -23,6 +23,7 @@ import (
 	"go/token"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/securego/gosec/v2/cwe"
 )
@@ -68,22 +69,74 @@ var ruleToCWE = map[string]string{
 	"G113": "190",
 	"G114": "676",
 	"G115": "190",
+	"G116": "614",
+	"G117": "400",
+	"G118": "942",
+	"G119": "1333",
+	"G120": "532",
+	"G121": "798",
+	"G122": "601",
+	"G123": "772",
+	"G124": "693",
+	"G125": "400",
+	"G127": "494",
+	"G128": "798",
+	"G129": "248",
+	"G130": "214",
+	"G131": "400",
+	"G132": "248",
+	"G133": "489",
+	"G134": "345",
+	"G135": "426",
+	"G136": "494",
+	"G137": "470",
+	"G138": "20",
+	"G139": "532",
 	"G201": "89",
 	"G202": "89",
 	"G203": "79",
 	"G204": "78",
+	"G205": "611",
+	"G206": "78",
+	"G207": "502",
+	"G208": "134",
+	"G209": "79",
+	"G210": "113",
 	"G301": "276",
 	"G302": "276",
 	"G303": "377",
 	"G304": "22",
 	"G305": "22",
 	"G306": "276",
+	"G308": "22",
+	"G309": "377",
+	"G310": "252",
+	"G311": "276",
+	"G312": "276",
+	"G313": "367",
 	"G401": "328",
 	"G402": "295",
 	"G403": "310",
 	"G404": "338",
 	"G405": "327",
 	"G406": "328",
+	"G407": "327",
+	"G408": "347",
+	"G409": "329",
+	"G410": "916",
+	"G411": "319",
+	"G412": "295",
+	"G413": "208",
+	"G414": "297",
+	"G415": "335",
+	"G416": "295",
+	"G417": "319",
+	"G418": "326",
+	"G419": "252",
+	"G420": "321",
+	"G421": "328",
+	"G422": "252",
+	"G423": "330",
 	"G501": "327",
 	"G502": "327",
 	"G503": "327",
@@ -93,6 +146,7 @@ var ruleToCWE = map[string]string{
 	"G507": "327",
 	"G601": "118",
 	"G602": "118",
+	"G603": "119",
 }
 
 // Issue is returned by a gosec rule if it discovers an issue with the scanned code.
@@ -106,8 +160,63 @@ type Issue struct {
 	Code         string            `json:"code"`         // Impacted code line
 	Line         string            `json:"line"`         // Line number in file
 	Col          string            `json:"column"`       // Column number in line
+	EndLine      string            `json:"end_line"`     // Line number where the issue ends
+	EndCol       string            `json:"end_column"`   // Column number following the end of the issue
 	NoSec        bool              `json:"nosec"`        // true if the issue is nosec
 	Suppressions []SuppressionInfo `json:"suppressions"` // Suppression info of the issue
+
+	// RelatedLocations lists the steps followed by a tainted value from its source to
+	// the location of the issue. It is empty for the rules which do not track taint.
+	RelatedLocations []Location `json:"related_locations,omitempty"`
+
+	// Autofix is a suggested change which remediates the issue. It is only set by
+	// the rules which have a deterministic remediation.
+	Autofix *Fix `json:"autofix,omitempty"`
+
+	// Context holds the source lines surrounding the issue. It is only set when the
+	// context is requested for the JSON report.
+	Context []ContextLine `json:"context,omitempty"`
+}
+
+// MarshalJSON adds to the JSON representation of the issue the severity and the confidence as
+// numbers, which can be compared by the consumers without mapping the labels
+func (i Issue) MarshalJSON() ([]byte, error) {
+	type plainIssue Issue
+	return json.Marshal(struct {
+		plainIssue
+		SeverityLevel   int `json:"severity_level"`
+		ConfidenceLevel int `json:"confidence_level"`
+	}{
+		plainIssue:      plainIssue(i),
+		SeverityLevel:   i.Severity.Level(),
+		ConfidenceLevel: i.Confidence.Level(),
+	})
+}
+
+// ContextLine is a line of the source code surrounding an issue
+type ContextLine struct {
+	Line int    `json:"line"` // Line number in file
+	Code string `json:"code"` // Source code of the line
+}
+
+// Fix is a suggested change of a file. The replacement text replaces the code going
+// from the start position up to the end position.
+type Fix struct {
+	Description string `json:"description"` // Human readable explanation of the change
+	File        string `json:"file"`        // File name
+	Line        string `json:"line"`        // Line number where the replaced code starts
+	Col         string `json:"column"`      // Column number where the replaced code starts
+	EndLine     string `json:"end_line"`    // Line number where the replaced code ends
+	EndCol      string `json:"end_column"`  // Column number following the end of the replaced code
+	Replacement string `json:"replacement"` // Text replacing the code
+}
+
+// Location points out a position in a file which is related to an issue
+type Location struct {
+	File    string `json:"file"`    // File name
+	Line    string `json:"line"`    // Line number in file
+	Col     string `json:"column"`  // Column number in line
+	Message string `json:"message"` // Human readable explanation of the location
 }
 
 // SuppressionInfo object is to record the kind and the justification that used
@@ -122,6 +231,17 @@ func (i *Issue) FileLocation() string {
 	return fmt.Sprintf("%s:%s", i.File, i.Line)
 }
 
+// Position point out the file path and the range of the issue in file as
+// start line:column-end line:column. It falls back to the file location when
+// the end position is unknown.
+func (i *Issue) Position() string {
+	if i.EndLine == "" || i.EndCol == "" {
+		return i.FileLocation()
+	}
+	start, _, _ := strings.Cut(i.Line, "-")
+	return fmt.Sprintf("%s:%s:%s-%s:%s", i.File, start, i.Col, i.EndLine, i.EndCol)
+}
+
 // MetaData is embedded in all gosec rules. The Severity, Confidence and What message
 // will be passed through to reported issues.
 type MetaData struct {
@@ -131,11 +251,21 @@ type MetaData struct {
 	What       string
 }
 
+// Metadata returns the metadata of the rule embedding it
+func (m MetaData) Metadata() MetaData {
+	return m
+}
+
 // MarshalJSON is used convert a Score object into a JSON representation
 func (c Score) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.String())
 }
 
+// Level converts a Score into a number which increases with the score, starting at 1 for Low
+func (c Score) Level() int {
+	return int(c) + 1
+}
+
 // String converts a Score into a string
 func (c Score) String() string {
 	switch c {
@@ -185,6 +315,7 @@ func New(fobj *token.File, node ast.Node, ruleID, desc string, severity, confide
 	name := fobj.Name()
 	line := GetLine(fobj, node)
 	col := strconv.Itoa(fobj.Position(node.Pos()).Column)
+	end := fobj.Position(node.End())
 
 	var code string
 	if node == nil {
@@ -204,6 +335,8 @@ func New(fobj *token.File, node ast.Node, ruleID, desc string, severity, confide
 		File:       name,
 		Line:       line,
 		Col:        col,
+		EndLine:    strconv.Itoa(end.Line),
+		EndCol:     strconv.Itoa(end.Column),
 		RuleID:     ruleID,
 		What:       desc,
 		Confidence: confidence,
@@ -213,6 +346,83 @@ func New(fobj *token.File, node ast.Node, ruleID, desc string, severity, confide
 	}
 }
 
+// NewLocation creates a new Location pointing out the start of the node
+func NewLocation(fobj *token.File, node ast.Node, message string) Location {
+	pos := fobj.Position(node.Pos())
+	return Location{
+		File:    fobj.Name(),
+		Line:    strconv.Itoa(pos.Line),
+		Col:     strconv.Itoa(pos.Column),
+		Message: message,
+	}
+}
+
+// NewFix creates a new Fix replacing the code of the node with the replacement text
+func NewFix(fobj *token.File, node ast.Node, description, replacement string) *Fix {
+	start := fobj.Position(node.Pos())
+	end := fobj.Position(node.End())
+	return &Fix{
+		Description: description,
+		File:        fobj.Name(),
+		Line:        strconv.Itoa(start.Line),
+		Col:         strconv.Itoa(start.Column),
+		EndLine:     strconv.Itoa(end.Line),
+		EndCol:      strconv.Itoa(end.Column),
+		Replacement: replacement,
+	}
+}
+
+// WithAutofix set the suggested fix of the issue
+func (i *Issue) WithAutofix(fix *Fix) *Issue {
+	i.Autofix = fix
+	return i
+}
+
+// WithRelatedLocations set the related locations of the issue
+func (i *Issue) WithRelatedLocations(locations []Location) *Issue {
+	i.RelatedLocations = locations
+	return i
+}
+
+// WithContext reads from the file of the issue the given number of lines before and after
+// the lines of the issue, and sets them as the context of the issue
+func (i *Issue) WithContext(lines int) error {
+	startLine, endLine, _ := strings.Cut(i.Line, "-")
+	start, err := strconv.Atoi(startLine)
+	if err != nil {
+		return fmt.Errorf("invalid issue line %q: %w", i.Line, err)
+	}
+	end := start
+	if endLine != "" {
+		if end, err = strconv.Atoi(endLine); err != nil {
+			return fmt.Errorf("invalid issue line %q: %w", i.Line, err)
+		}
+	}
+	file, err := os.Open(i.File)
+	if err != nil {
+		return err
+	}
+	defer file.Close() // #nosec
+
+	contextLines := []ContextLine{}
+	pos := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		pos++
+		if pos > end+lines {
+			break
+		}
+		if pos >= start-lines {
+			contextLines = append(contextLines, ContextLine{Line: pos, Code: scanner.Text()})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	i.Context = contextLines
+	return nil
+}
+
 // WithSuppressions set the suppressions of the issue
 func (i *Issue) WithSuppressions(suppressions []SuppressionInfo) *Issue {
 	i.Suppressions = suppressions
@@ -1,7 +1,9 @@
 package issue_test
 
 import (
+	"encoding/json"
 	"go/ast"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -44,6 +46,8 @@ var _ = Describe("Issue", func() {
 			Expect(issue.Code).Should(MatchRegexp(`"bar"`))
 			Expect(issue.Line).Should(Equal("2"))
 			Expect(issue.Col).Should(Equal("16"))
+			Expect(issue.EndLine).Should(Equal("2"))
+			Expect(issue.EndCol).Should(Equal("21"))
 			Expect(issue.Cwe).Should(BeNil())
 		})
 
@@ -127,6 +131,52 @@ func main() {
 			Expect(issue.File).Should(MatchRegexp("foo.go"))
 			Expect(issue.Line).Should(MatchRegexp("7-8"))
 			Expect(issue.Col).Should(Equal("10"))
+			Expect(issue.EndLine).Should(Equal("8"))
+			Expect(issue.EndCol).Should(Equal("17"))
+			Expect(issue.Position()).Should(MatchRegexp(`foo\.go:7:10-8:17$`))
+		})
+
+		It("should fall back to the file location when the end position is unknown", func() {
+			i := issue.Issue{File: "foo.go", Line: "3", Col: "5"}
+			Expect(i.Position()).Should(Equal("foo.go:3"))
+			i.EndLine, i.EndCol = "3", "12"
+			Expect(i.Position()).Should(Equal("foo.go:3:5-3:12"))
+		})
+
+		It("should include the source lines surrounding the issue as context", func() {
+			pkg := testutils.NewTestPackage()
+			defer pkg.Close()
+			pkg.AddFile("foo.go", `package main
+
+import "net"
+
+func main() {
+	_, _ = net.Listen("tcp",
+		"0.0.0.0:2000")
+}
+`)
+			Expect(pkg.Build()).Should(Succeed())
+			i := issue.Issue{File: filepath.Join(pkg.Path, "foo.go"), Line: "6-7"}
+			Expect(i.Context).Should(BeNil())
+			raw, err := json.Marshal(i)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(raw)).ShouldNot(ContainSubstring(`"context"`))
+
+			Expect(i.WithContext(1)).Should(Succeed())
+			Expect(i.Context).Should(Equal([]issue.ContextLine{
+				{Line: 5, Code: "func main() {"},
+				{Line: 6, Code: "\t_, _ = net.Listen(\"tcp\","},
+				{Line: 7, Code: "\t\t\"0.0.0.0:2000\")"},
+				{Line: 8, Code: "}"},
+			}))
+
+			Expect(i.WithContext(3)).Should(Succeed())
+			Expect(i.Context).Should(HaveLen(6))
+			Expect(i.Context[0].Line).Should(Equal(3))
+			Expect(i.Context[5].Line).Should(Equal(8))
+			raw, err = json.Marshal(i)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(raw)).Should(ContainSubstring(`"context":[{"line":3,"code":"import \"net\""}`))
 		})
 
 		It("should maintain the provided severity score", func() {
@@ -0,0 +1,58 @@
+package gosec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathStyle defines how the file paths are written in the reports
+type PathStyle string
+
+const (
+	// PathStyleAbsolute keeps the absolute file paths
+	PathStyleAbsolute PathStyle = "absolute"
+	// PathStyleRelative writes the file paths relative to the working directory
+	PathStyleRelative PathStyle = "relative"
+	// PathStyleModule writes the file paths relative to the root of their module
+	PathStyleModule PathStyle = "module"
+)
+
+// ParsePathStyle converts the value of the path style option, which defaults to absolute
+func ParsePathStyle(value string) (PathStyle, error) {
+	switch style := PathStyle(strings.ToLower(value)); style {
+	case "":
+		return PathStyleAbsolute, nil
+	case PathStyleAbsolute, PathStyleRelative, PathStyleModule:
+		return style, nil
+	default:
+		return "", fmt.Errorf("invalid path style %q, valid options are: absolute, relative, module", value)
+	}
+}
+
+// Path converts an absolute file path to the style. The paths which cannot be converted
+// are returned unchanged.
+func (s PathStyle) Path(path string) string {
+	if !filepath.IsAbs(path) {
+		return path
+	}
+	var base string
+	switch s {
+	case PathStyleRelative:
+		wd, err := os.Getwd()
+		if err != nil {
+			return path
+		}
+		base = wd
+	case PathStyleModule:
+		base = moduleRoot(filepath.Dir(path))
+	default:
+		return path
+	}
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,74 @@
+package gosec_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/baseline"
+	"github.com/securego/gosec/v2/issue"
+)
+
+var _ = Describe("Path style", func() {
+	newModule := func() string {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/project\n"), 0o600)).To(Succeed())
+		return dir
+	}
+
+	newReport := func(file string) *gosec.ReportInfo {
+		i := &issue.Issue{
+			File:             file,
+			RuleID:           "G101",
+			Line:             "3",
+			Code:             "3: password := \"secret\"\n",
+			RelatedLocations: []issue.Location{{File: file, Line: "2"}},
+		}
+		errors := map[string][]gosec.Error{file: {{Line: 1, Column: 1, Err: "error"}}}
+		return gosec.NewReportInfo([]*issue.Issue{i}, &gosec.Metrics{}, errors)
+	}
+
+	It("should parse the path styles", func() {
+		style, err := gosec.ParsePathStyle("")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(style).To(Equal(gosec.PathStyleAbsolute))
+		style, err = gosec.ParsePathStyle("Module")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(style).To(Equal(gosec.PathStyleModule))
+		_, err = gosec.ParsePathStyle("home")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("should keep the absolute paths", func() {
+		file := filepath.Join(newModule(), "pkg", "main.go")
+		styled := newReport(file).WithPathStyle(gosec.PathStyleAbsolute)
+		Expect(styled.Issues[0].File).To(Equal(file))
+	})
+
+	It("should write the paths relative to the working directory", func() {
+		wd, err := os.Getwd()
+		Expect(err).ShouldNot(HaveOccurred())
+		styled := newReport(filepath.Join(wd, "pkg", "main.go")).WithPathStyle(gosec.PathStyleRelative)
+		Expect(styled.Issues[0].File).To(Equal("pkg/main.go"))
+	})
+
+	It("should write the paths relative to the module root", func() {
+		file := filepath.Join(newModule(), "pkg", "main.go")
+		report := newReport(file)
+		styled := report.WithPathStyle(gosec.PathStyleModule)
+		Expect(styled.Issues[0].File).To(Equal("pkg/main.go"))
+		Expect(styled.Issues[0].RelatedLocations[0].File).To(Equal("pkg/main.go"))
+		Expect(styled.Errors).To(HaveKey("pkg/main.go"))
+		Expect(report.Issues[0].File).To(Equal(file), "the report is left unchanged")
+		Expect(report.Issues[0].RelatedLocations[0].File).To(Equal(file))
+	})
+
+	It("should keep the fingerprints stable across checkouts with the module style", func() {
+		first := newReport(filepath.Join(newModule(), "pkg", "main.go")).WithPathStyle(gosec.PathStyleModule)
+		second := newReport(filepath.Join(newModule(), "pkg", "main.go")).WithPathStyle(gosec.PathStyleModule)
+		Expect(baseline.Fingerprint(first.Issues[0])).To(Equal(baseline.Fingerprint(second.Issues[0])))
+	})
+})
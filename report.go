@@ -21,6 +21,49 @@ func NewReportInfo(issues []*issue.Issue, metrics *Metrics, errors map[string][]
 	}
 }
 
+// WithCodeContext adds to every issue the given number of source lines surrounding it.
+// The issues whose file cannot be read are left without context.
+func (r *ReportInfo) WithCodeContext(lines int) *ReportInfo {
+	for _, i := range r.Issues {
+		_ = i.WithContext(lines)
+	}
+	return r
+}
+
+// WithPathStyle returns a copy of the report whose file paths are written in the given style.
+// The issues are copied, so the report itself is left unchanged.
+func (r *ReportInfo) WithPathStyle(style PathStyle) *ReportInfo {
+	if style == PathStyleAbsolute {
+		return r
+	}
+	styled := *r
+	styled.Issues = make([]*issue.Issue, 0, len(r.Issues))
+	for _, i := range r.Issues {
+		copied := *i
+		copied.File = style.Path(i.File)
+		if i.RelatedLocations != nil {
+			copied.RelatedLocations = make([]issue.Location, len(i.RelatedLocations))
+			for n, location := range i.RelatedLocations {
+				location.File = style.Path(location.File)
+				copied.RelatedLocations[n] = location
+			}
+		}
+		if i.Autofix != nil {
+			fix := *i.Autofix
+			fix.File = style.Path(fix.File)
+			copied.Autofix = &fix
+		}
+		styled.Issues = append(styled.Issues, &copied)
+	}
+	if r.Errors != nil {
+		styled.Errors = make(map[string][]Error, len(r.Errors))
+		for file, errors := range r.Errors {
+			styled.Errors[style.Path(file)] = errors
+		}
+	}
+	return &styled
+}
+
 // WithVersion defines the version of gosec used to generate the report
 func (r *ReportInfo) WithVersion(version string) *ReportInfo {
 	r.GosecVersion = version
@@ -0,0 +1,19 @@
+package checkstyle
+
+// NewFile instantiate a File
+func NewFile(name string) *File {
+	return &File{
+		Name: name,
+	}
+}
+
+// NewError instantiate an Error
+func NewError(line int, column int, severity string, message string, source string) *Error {
+	return &Error{
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Message:  message,
+		Source:   source,
+	}
+}
@@ -0,0 +1,102 @@
+package checkstyle_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/checkstyle"
+)
+
+func newReportInfo() *gosec.ReportInfo {
+	return gosec.NewReportInfo([]*issue.Issue{
+		{
+			Severity:   issue.High,
+			Confidence: issue.Low,
+			RuleID:     "G101",
+			What:       "Potential hardcoded credentials",
+			File:       "/home/src/project/main.go",
+			Line:       "4",
+			Col:        "2",
+		},
+		{
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			RuleID:     "G304",
+			What:       "Potential file inclusion via variable",
+			File:       "/home/src/project/pkg/file.go",
+			Line:       "10-11",
+			Col:        "9",
+		},
+		{
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			RuleID:     "G104",
+			What:       "Errors unhandled.",
+			File:       "/home/src/project/main.go",
+			Line:       "12",
+			Col:        "3",
+		},
+	}, &gosec.Metrics{}, map[string][]gosec.Error{})
+}
+
+var _ = Describe("Checkstyle Formatter", func() {
+	Context("when converting to Checkstyle report", func() {
+		It("it should match the golden report", func() {
+			buf := new(bytes.Buffer)
+			err := checkstyle.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			golden, err := os.ReadFile(filepath.Join("testdata", "report.xml"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(string(bytes.TrimSpace(golden))))
+		})
+
+		It("it should group the issues by file", func() {
+			report := checkstyle.GenerateReport(newReportInfo())
+			Expect(report.Files).To(HaveLen(2))
+			Expect(report.Files[0].Name).To(Equal("/home/src/project/main.go"))
+			Expect(report.Files[0].Errors).To(HaveLen(2))
+			Expect(report.Files[1].Name).To(Equal("/home/src/project/pkg/file.go"))
+			Expect(report.Files[1].Errors).To(HaveLen(1))
+			Expect(report.Files[1].Errors[0].Line).To(Equal(10))
+		})
+
+		It("it should produce a valid XML document", func() {
+			buf := new(bytes.Buffer)
+			err := checkstyle.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			report := checkstyle.Report{}
+			Expect(xml.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+			Expect(report.Files).To(HaveLen(2))
+		})
+
+		DescribeTable("it should map the gosec severity to the Checkstyle severity",
+			func(severity issue.Score, expected string) {
+				data := &gosec.ReportInfo{
+					Issues: []*issue.Issue{
+						{
+							Severity: severity,
+							RuleID:   "test",
+							What:     "test",
+							File:     "test.go",
+							Line:     "1",
+						},
+					},
+				}
+				report := checkstyle.GenerateReport(data)
+				Expect(report.Files[0].Errors[0].Severity).To(Equal(expected))
+				Expect(report.Files[0].Errors[0].Source).To(Equal("test"))
+			},
+			Entry("HIGH", issue.High, "error"),
+			Entry("MEDIUM", issue.Medium, "warning"),
+			Entry("LOW", issue.Low, "info"),
+		)
+	})
+})
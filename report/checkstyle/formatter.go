@@ -0,0 +1,49 @@
+package checkstyle
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// Version is the version of the Checkstyle format
+const Version = "5.0"
+
+// GenerateReport converts a gosec report to a Checkstyle report where the issues are grouped by file
+func GenerateReport(data *gosec.ReportInfo) Report {
+	report := Report{Version: Version}
+	files := map[string]int{}
+
+	for _, issue := range data.Issues {
+		index, ok := files[issue.File]
+		if !ok {
+			report.Files = append(report.Files, NewFile(issue.File))
+			index = len(report.Files) - 1
+			files[issue.File] = index
+		}
+		line, _ := strconv.Atoi(strings.Split(issue.Line, "-")[0])
+		column, _ := strconv.Atoi(issue.Col)
+		report.Files[index].Errors = append(report.Files[index].Errors,
+			NewError(line, column, getCheckstyleSeverity(issue.Severity), issue.What, issue.RuleID))
+	}
+
+	return report
+}
+
+// getCheckstyleSeverity maps the gosec severity to the Checkstyle severity:
+//
+//	HIGH   -> error
+//	MEDIUM -> warning
+//	LOW    -> info
+func getCheckstyleSeverity(s issue.Score) string {
+	switch s {
+	case issue.High:
+		return "error"
+	case issue.Medium:
+		return "warning"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,29 @@
+package checkstyle
+
+import (
+	"encoding/xml"
+)
+
+// Report defines a Checkstyle XML report
+type Report struct {
+	XMLName xml.Name `xml:"checkstyle"`
+	Version string   `xml:"version,attr"`
+	Files   []*File  `xml:"file"`
+}
+
+// File defines the issues found in a file
+type File struct {
+	XMLName xml.Name `xml:"file"`
+	Name    string   `xml:"name,attr"`
+	Errors  []*Error `xml:"error"`
+}
+
+// Error defines an issue found in a file
+type Error struct {
+	XMLName  xml.Name `xml:"error"`
+	Line     int      `xml:"line,attr"`
+	Column   int      `xml:"column,attr,omitempty"`
+	Severity string   `xml:"severity,attr"`
+	Message  string   `xml:"message,attr"`
+	Source   string   `xml:"source,attr"`
+}
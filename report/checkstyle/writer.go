@@ -0,0 +1,21 @@
+package checkstyle
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/securego/gosec/v2"
+)
+
+// WriteReport write a report in Checkstyle format to the output writer
+func WriteReport(w io.Writer, data *gosec.ReportInfo) error {
+	report := GenerateReport(data)
+	raw, err := xml.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	raw = append([]byte(xml.Header), raw...)
+	_, err = w.Write(raw)
+	return err
+}
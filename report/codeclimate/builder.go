@@ -0,0 +1,12 @@
+package codeclimate
+
+// NewLocation instantiate a Location
+func NewLocation(path string, begin int, end int) *Location {
+	return &Location{
+		Path: path,
+		Lines: &Lines{
+			Begin: begin,
+			End:   end,
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package codeclimate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/codeclimate"
+)
+
+func newReportInfo() *gosec.ReportInfo {
+	return gosec.NewReportInfo([]*issue.Issue{
+		{
+			Severity:   issue.High,
+			Confidence: issue.Low,
+			RuleID:     "G101",
+			What:       "Potential hardcoded credentials",
+			File:       "/home/src/project/main.go",
+			Code:       "4: password := \"f62e5bcda4fae4f82370da0c6f20697b8f8447ef\"",
+			Line:       "4",
+			Col:        "2",
+		},
+		{
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			RuleID:     "G304",
+			What:       "Potential file inclusion via variable",
+			File:       "/home/src/project/pkg/file.go",
+			Code:       "10: os.ReadFile(path)\n11: ",
+			Line:       "10-11",
+			Col:        "9",
+		},
+	}, &gosec.Metrics{}, map[string][]gosec.Error{})
+}
+
+var _ = Describe("CodeClimate Formatter", func() {
+	Context("when converting to CodeClimate report", func() {
+		It("it should match the golden report", func() {
+			buf := new(bytes.Buffer)
+			err := codeclimate.WriteReport(buf, newReportInfo(), []string{"/home/src/project"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			golden, err := os.ReadFile(filepath.Join("testdata", "report.json"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(string(bytes.TrimSpace(golden))))
+		})
+
+		It("it should produce a JSON array with the required fields", func() {
+			buf := new(bytes.Buffer)
+			err := codeclimate.WriteReport(buf, newReportInfo(), []string{"/home/src/project"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var issues []map[string]interface{}
+			Expect(json.Unmarshal(buf.Bytes(), &issues)).To(Succeed())
+			Expect(issues).To(HaveLen(2))
+			for _, i := range issues {
+				Expect(i).To(HaveKey("description"))
+				Expect(i).To(HaveKey("check_name"))
+				Expect(i).To(HaveKey("fingerprint"))
+				Expect(i).To(HaveKey("severity"))
+				Expect(i).To(HaveKeyWithValue("location", HaveKey("path")))
+				Expect(i).To(HaveKeyWithValue("location", HaveKeyWithValue("lines", HaveKey("begin"))))
+			}
+		})
+
+		It("it should keep the fingerprint stable across runs", func() {
+			first, err := codeclimate.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := codeclimate.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(first[0].Fingerprint).To(Equal(second[0].Fingerprint))
+			Expect(first[1].Fingerprint).To(Equal(second[1].Fingerprint))
+			Expect(first[0].Fingerprint).NotTo(Equal(first[1].Fingerprint))
+		})
+
+		It("it should keep the fingerprint stable when the checkout directory changes", func() {
+			data := newReportInfo()
+			first, err := codeclimate.GenerateReport([]string{"/home/src/project"}, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			for _, i := range data.Issues {
+				i.File = filepath.Join("/tmp/build", i.File[len("/home/src/project"):])
+			}
+			second, err := codeclimate.GenerateReport([]string{"/tmp/build"}, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second[0].Fingerprint).To(Equal(first[0].Fingerprint))
+		})
+
+		It("it should keep the fingerprint stable when the code moves to another line", func() {
+			first, err := codeclimate.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			data := newReportInfo()
+			data.Issues[0].Code = "12: password := \"f62e5bcda4fae4f82370da0c6f20697b8f8447ef\""
+			data.Issues[0].Line = "12"
+			data.Issues[1].Code = "10: os.ReadFile(other)\n11: "
+			second, err := codeclimate.GenerateReport([]string{"/home/src/project"}, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second[0].Fingerprint).To(Equal(first[0].Fingerprint))
+			Expect(second[1].Fingerprint).NotTo(Equal(first[1].Fingerprint))
+		})
+
+		DescribeTable("it should map the gosec severity to the CodeClimate severity",
+			func(severity issue.Score, confidence issue.Score, expected string) {
+				data := &gosec.ReportInfo{
+					Issues: []*issue.Issue{
+						{
+							Severity:   severity,
+							Confidence: confidence,
+							RuleID:     "test",
+							What:       "test",
+							File:       "test.go",
+							Line:       "1",
+						},
+					},
+				}
+				issues, err := codeclimate.GenerateReport([]string{}, data)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(issues[0].Severity).To(Equal(expected))
+			},
+			Entry("HIGH with HIGH confidence", issue.High, issue.High, "blocker"),
+			Entry("HIGH", issue.High, issue.Medium, "critical"),
+			Entry("MEDIUM", issue.Medium, issue.High, "major"),
+			Entry("LOW", issue.Low, issue.High, "minor"),
+			Entry("unknown", issue.Score(-1), issue.High, "info"),
+		)
+	})
+})
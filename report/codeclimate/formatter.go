@@ -0,0 +1,81 @@
+package codeclimate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/baseline"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const (
+	// IssueType is the type of all the entries of the report
+	IssueType = "issue"
+	// Category of the issues reported by gosec
+	Category = "Security"
+)
+
+// GenerateReport converts a gosec report into a list of CodeClimate issues
+func GenerateReport(rootPaths []string, data *gosec.ReportInfo) ([]*Issue, error) {
+	issues := []*Issue{}
+	for _, issue := range data.Issues {
+		location, err := parseLocation(issue, rootPaths)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, &Issue{
+			Type:        IssueType,
+			Description: fmt.Sprintf("[%s] %s", issue.RuleID, issue.What),
+			CheckName:   issue.RuleID,
+			Categories:  []string{Category},
+			Fingerprint: baseline.FingerprintPath(issue, location.Path),
+			Severity:    getCodeClimateSeverity(issue),
+			Location:    location,
+		})
+	}
+	return issues, nil
+}
+
+func parseLocation(issue *issue.Issue, rootPaths []string) (*Location, error) {
+	path := issue.File
+	for _, rootPath := range rootPaths {
+		if rel, err := filepath.Rel(rootPath, issue.File); err == nil && !strings.HasPrefix(rel, "..") {
+			path = filepath.ToSlash(rel)
+			break
+		}
+	}
+	lines := strings.Split(issue.Line, "-")
+	begin, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return nil, err
+	}
+	end := begin
+	if len(lines) > 1 {
+		end, err = strconv.Atoi(lines[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewLocation(path, begin, end), nil
+}
+
+// getCodeClimateSeverity maps the gosec severity to the CodeClimate severity. A high
+// severity issue reported with high confidence is a blocker.
+func getCodeClimateSeverity(i *issue.Issue) string {
+	switch i.Severity {
+	case issue.Low:
+		return "minor"
+	case issue.Medium:
+		return "major"
+	case issue.High:
+		if i.Confidence == issue.High {
+			return "blocker"
+		}
+		return "critical"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,24 @@
+package codeclimate
+
+// Issue is a code quality issue in the CodeClimate format
+type Issue struct {
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	CheckName   string    `json:"check_name"`
+	Categories  []string  `json:"categories"`
+	Fingerprint string    `json:"fingerprint"`
+	Severity    string    `json:"severity"`
+	Location    *Location `json:"location"`
+}
+
+// Location is the place in the source code where an issue was found
+type Location struct {
+	Path  string `json:"path"`
+	Lines *Lines `json:"lines"`
+}
+
+// Lines are the first and the last line of an issue
+type Lines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end"`
+}
@@ -0,0 +1,22 @@
+package codeclimate
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/securego/gosec/v2"
+)
+
+// WriteReport write a report in CodeClimate format to the output writer
+func WriteReport(w io.Writer, data *gosec.ReportInfo, rootPaths []string) error {
+	issues, err := GenerateReport(rootPaths, data)
+	if err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(issues, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	return err
+}
@@ -19,13 +19,18 @@ import (
 
 	"github.com/securego/gosec/v2"
 	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/checkstyle"
+	"github.com/securego/gosec/v2/report/codeclimate"
 	"github.com/securego/gosec/v2/report/csv"
+	"github.com/securego/gosec/v2/report/gitlab"
 	"github.com/securego/gosec/v2/report/golint"
 	"github.com/securego/gosec/v2/report/html"
 	"github.com/securego/gosec/v2/report/json"
+	"github.com/securego/gosec/v2/report/jsonl"
 	"github.com/securego/gosec/v2/report/junit"
 	"github.com/securego/gosec/v2/report/sarif"
 	"github.com/securego/gosec/v2/report/sonar"
+	"github.com/securego/gosec/v2/report/tap"
 	"github.com/securego/gosec/v2/report/text"
 	"github.com/securego/gosec/v2/report/yaml"
 )
@@ -51,27 +56,40 @@ const (
 )
 
 // CreateReport generates a report based for the supplied issues and metrics given
-// the specified format. The formats currently accepted are: json, yaml, csv, junit-xml, html, sonarqube, golint and text.
+// the specified format. The formats currently accepted are: json, jsonl, yaml, csv, junit-xml, checkstyle, html, sonarqube, gitlab, codeclimate, golint, tap and text.
 func CreateReport(w io.Writer, format string, enableColor bool, rootPaths []string, data *gosec.ReportInfo) error {
 	var err error
-	if format != "json" && format != "sarif" {
-		data.Issues = filterOutSuppressedIssues(data.Issues)
+	if format != "json" && format != "jsonl" && format != "sarif" {
+		// the report data is copied since it can be written in several formats
+		filtered := *data
+		filtered.Issues = filterOutSuppressedIssues(data.Issues)
+		data = &filtered
 	}
 	switch format {
 	case "json":
 		err = json.WriteReport(w, data)
+	case "jsonl":
+		err = jsonl.WriteReport(w, data)
 	case "yaml":
 		err = yaml.WriteReport(w, data)
 	case "csv":
 		err = csv.WriteReport(w, data)
 	case "junit-xml":
 		err = junit.WriteReport(w, data)
+	case "checkstyle":
+		err = checkstyle.WriteReport(w, data)
 	case "html":
 		err = html.WriteReport(w, data)
 	case "text":
 		err = text.WriteReport(w, data, enableColor)
 	case "sonarqube":
 		err = sonar.WriteReport(w, data, rootPaths)
+	case "gitlab":
+		err = gitlab.WriteReport(w, data, rootPaths)
+	case "codeclimate":
+		err = codeclimate.WriteReport(w, data, rootPaths)
+	case "tap":
+		err = tap.WriteReport(w, data)
 	case "golint":
 		err = golint.WriteReport(w, data)
 	case "sarif":
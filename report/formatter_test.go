@@ -15,6 +15,7 @@ import (
 	"github.com/securego/gosec/v2/issue"
 	"github.com/securego/gosec/v2/report/junit"
 	"github.com/securego/gosec/v2/report/sonar"
+	"github.com/securego/gosec/v2/report/text"
 )
 
 func createIssueWithFileWhat(file, what string) *issue.Issue {
@@ -441,6 +442,54 @@ var _ = Describe("Formatter", func() {
 				Expect(result).ShouldNot(ContainSubstring(expectation))
 			}
 		})
+		It("gitlab formatted report should contain the CWE mapping", func() {
+			for _, rule := range grules {
+				cwe := issue.GetCweByRule(rule)
+				newissue := createIssue(rule, cwe)
+				errors := map[string][]gosec.Error{}
+				buf := new(bytes.Buffer)
+				reportInfo := gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, errors)
+				err := CreateReport(buf, "gitlab", false, []string{"/home/src/project"}, reportInfo)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring(cwe.SprintURL()))
+			}
+		})
+		It("checkstyle formatted report should contain the rule ID", func() {
+			for _, rule := range grules {
+				cwe := issue.GetCweByRule(rule)
+				newissue := createIssue(rule, cwe)
+				errors := map[string][]gosec.Error{}
+				buf := new(bytes.Buffer)
+				reportInfo := gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, errors)
+				err := CreateReport(buf, "checkstyle", false, []string{}, reportInfo)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring(fmt.Sprintf(`source="%s"`, rule)))
+			}
+		})
+		It("codeclimate formatted report should contain the rule ID", func() {
+			for _, rule := range grules {
+				cwe := issue.GetCweByRule(rule)
+				newissue := createIssue(rule, cwe)
+				errors := map[string][]gosec.Error{}
+				buf := new(bytes.Buffer)
+				reportInfo := gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, errors)
+				err := CreateReport(buf, "codeclimate", false, []string{}, reportInfo)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring(fmt.Sprintf(`"check_name": "%s"`, rule)))
+			}
+		})
+		It("tap formatted report should contain the CWE mapping", func() {
+			for _, rule := range grules {
+				cwe := issue.GetCweByRule(rule)
+				newissue := createIssue(rule, cwe)
+				errors := map[string][]gosec.Error{}
+				buf := new(bytes.Buffer)
+				reportInfo := gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, errors)
+				err := CreateReport(buf, "tap", false, []string{}, reportInfo)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(buf.String()).To(ContainSubstring("cwe: " + cwe.SprintID()))
+			}
+		})
 		It("golint formatted report should contain the CWE mapping", func() {
 			for _, rule := range grules {
 				cwe := issue.GetCweByRule(rule)
@@ -490,6 +539,186 @@ var _ = Describe("Formatter", func() {
 		})
 	})
 
+	Context("When sorting the issues of the JSON report", func() {
+		It("should contain the numeric levels and sort by severity, file and line", func() {
+			newIssue := func(file, line string, severity, confidence issue.Score) *issue.Issue {
+				i := createIssue("G101", issue.GetCweByRule("G101"))
+				i.File, i.Line, i.Severity, i.Confidence = file, line, severity, confidence
+				return &i
+			}
+			issues := []*issue.Issue{
+				newIssue("/home/src/project/b.go", "10", issue.Low, issue.High),
+				newIssue("/home/src/project/b.go", "12-14", issue.High, issue.Low),
+				newIssue("/home/src/project/a.go", "20", issue.Medium, issue.Medium),
+				newIssue("/home/src/project/b.go", "3", issue.High, issue.Medium),
+				newIssue("/home/src/project/a.go", "7", issue.High, issue.High),
+			}
+			reportInfo := gosec.NewReportInfo(issues, &gosec.Metrics{}, map[string][]gosec.Error{})
+
+			buf := new(bytes.Buffer)
+			Expect(CreateReport(buf, "json", false, []string{}, reportInfo)).To(Succeed())
+
+			type level struct {
+				File            string `json:"file"`
+				Line            string `json:"line"`
+				SeverityLevel   int    `json:"severity_level"`
+				ConfidenceLevel int    `json:"confidence_level"`
+			}
+			result := struct {
+				Issues []level `json:"Issues"`
+			}{}
+			Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+			Expect(result.Issues).To(Equal([]level{
+				{"/home/src/project/a.go", "7", 3, 3},
+				{"/home/src/project/b.go", "3", 3, 2},
+				{"/home/src/project/b.go", "12-14", 3, 1},
+				{"/home/src/project/a.go", "20", 2, 2},
+				{"/home/src/project/b.go", "10", 1, 3},
+			}))
+			Expect(reportInfo.Issues[0].File).To(Equal("/home/src/project/b.go"), "the report data is not reordered")
+		})
+	})
+
+	Context("When reporting the end position of the issues", func() {
+		newReportInfo := func() *gosec.ReportInfo {
+			newissue := createIssue("G101", issue.GetCweByRule("G101"))
+			newissue.Line = "1-2"
+			newissue.Col = "5"
+			newissue.EndLine = "2"
+			newissue.EndCol = "17"
+			return gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, map[string][]gosec.Error{})
+		}
+
+		It("json formatted report should round-trip the positions", func() {
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "json", false, []string{}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result := struct {
+				Issues []struct {
+					Line    string `json:"line"`
+					Col     string `json:"column"`
+					EndLine string `json:"end_line"`
+					EndCol  string `json:"end_column"`
+				} `json:"Issues"`
+			}{}
+			Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+			Expect(result.Issues).To(HaveLen(1))
+			Expect(result.Issues[0].Line).To(Equal("1-2"))
+			Expect(result.Issues[0].Col).To(Equal("5"))
+			Expect(result.Issues[0].EndLine).To(Equal("2"))
+			Expect(result.Issues[0].EndCol).To(Equal("17"))
+		})
+
+		It("text formatted report should contain the range of the issue", func() {
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "text", false, []string{}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("[/home/src/project/test.go:1:5-2:17] - G101"))
+		})
+	})
+
+	Context("When using the text format", func() {
+		newReportInfo := func() *gosec.ReportInfo {
+			issues := []*issue.Issue{
+				createIssueWithFileWhat("/home/src/project/b.go", "first"),
+				createIssueWithFileWhat("/home/src/project/a.go", "second"),
+				createIssueWithFileWhat("/home/src/project/b.go", "third"),
+			}
+			issues[0].RuleID, issues[0].Severity = "G104", issue.Low
+			issues[1].RuleID, issues[1].Severity = "G101", issue.High
+			issues[2].RuleID, issues[2].Severity = "G101", issue.Medium
+			return gosec.NewReportInfo(issues, &gosec.Metrics{NumFound: 3}, map[string][]gosec.Error{})
+		}
+
+		It("should contain ANSI color codes when colors are enabled", func() {
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "text", true, []string{}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("\x1b["))
+			Expect(buf.String()).To(MatchRegexp("\x1b\\[[0-9;]+mG101\x1b\\[0m"))
+			Expect(buf.String()).To(MatchRegexp("\x1b\\[[0-9;]+mHIGH\x1b\\[0m"))
+		})
+
+		It("should not contain ANSI color codes when colors are disabled", func() {
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "text", false, []string{}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).NotTo(ContainSubstring("\x1b["))
+		})
+
+		DescribeTable("should group the issues in sections",
+			func(groupBy text.GroupBy, headers []string, order []string) {
+				buf := new(bytes.Buffer)
+				err := text.WriteGroupedReport(buf, newReportInfo(), false, groupBy)
+				Expect(err).ShouldNot(HaveOccurred())
+				result := buf.String()
+
+				position := -1
+				for _, header := range headers {
+					index := strings.Index(result, "\n"+header+"\n")
+					Expect(index).To(BeNumerically(">", position), "header %q", header)
+					position = index
+				}
+				position = -1
+				for _, what := range order {
+					index := strings.Index(result, ": "+what+" (")
+					Expect(index).To(BeNumerically(">", position), "issue %q", what)
+					position = index
+				}
+			},
+			Entry("by severity", text.GroupBySeverity,
+				[]string{"Severity: HIGH (1)", "Severity: MEDIUM (1)", "Severity: LOW (1)"},
+				[]string{"second", "third", "first"}),
+			Entry("by file", text.GroupByFile,
+				[]string{"File: /home/src/project/a.go (1)", "File: /home/src/project/b.go (2)"},
+				[]string{"second", "first", "third"}),
+			Entry("by rule", text.GroupByRule,
+				[]string{"Rule: G101 (2)", "Rule: G104 (1)"},
+				[]string{"second", "third", "first"}),
+		)
+
+		It("should not add section headers without grouping", func() {
+			grouped := new(bytes.Buffer)
+			err := text.WriteGroupedReport(grouped, newReportInfo(), false, text.GroupByNone)
+			Expect(err).ShouldNot(HaveOccurred())
+			plain := new(bytes.Buffer)
+			err = CreateReport(plain, "text", false, []string{}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(grouped.String()).To(Equal(plain.String()))
+			Expect(plain.String()).NotTo(ContainSubstring("File: "))
+		})
+
+		It("should contain the CWE links when enabled", func() {
+			buf := new(bytes.Buffer)
+			err := text.WriteReportWithOptions(buf, newReportInfo(), false, text.Options{CWELinks: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("\n  https://cwe.mitre.org/data/definitions/798.html\n"))
+		})
+
+		It("should count the skipped files in the summary", func() {
+			reportInfo := newReportInfo()
+			reportInfo.Stats.SkippedFiles = []string{"/home/src/project/assets.go"}
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "text", false, []string{}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring("  Nosec  : 0\n  Skipped: 1\n  Issues : 3\n"))
+		})
+
+		It("should not contain the CWE links when disabled", func() {
+			buf := new(bytes.Buffer)
+			err := text.WriteReportWithOptions(buf, newReportInfo(), false, text.Options{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).NotTo(ContainSubstring("https://cwe.mitre.org"))
+		})
+
+		It("should fail with an unknown grouping", func() {
+			buf := new(bytes.Buffer)
+			err := text.WriteGroupedReport(buf, newReportInfo(), false, text.GroupBy("cwe"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("When converting suppressed issues", func() {
 		ruleID := "G101"
 		cwe := issue.GetCweByRule(ruleID)
@@ -526,6 +755,40 @@ var _ = Describe("Formatter", func() {
 			Expect(result).To(ContainSubstring(`"results":[{`))
 		})
 
+		It("jsonl formatted report should contain one complete issue per line", func() {
+			issues := []*issue.Issue{}
+			for _, rule := range []string{"G101", "G104", "G401"} {
+				newissue := createIssue(rule, issue.GetCweByRule(rule))
+				issues = append(issues, &newissue)
+			}
+			reportInfo := gosec.NewReportInfo(issues, &gosec.Metrics{}, map[string][]gosec.Error{})
+
+			buf := new(bytes.Buffer)
+			err := CreateReport(buf, "jsonl", false, []string{}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			Expect(lines).To(HaveLen(len(issues)))
+			for i, line := range lines {
+				result := map[string]interface{}{}
+				Expect(json.Unmarshal([]byte(line), &result)).To(Succeed())
+				Expect(result).To(HaveKeyWithValue("rule_id", issues[i].RuleID))
+				Expect(result).To(HaveKeyWithValue("severity", "HIGH"))
+				Expect(result).To(HaveKeyWithValue("confidence", "HIGH"))
+				Expect(result).To(HaveKeyWithValue("file", issues[i].File))
+				Expect(result).To(HaveKeyWithValue("line", issues[i].Line))
+				Expect(result).To(HaveKeyWithValue("details", issues[i].What))
+				Expect(result).To(HaveKeyWithValue("cwe", HaveKeyWithValue("id", issues[i].Cwe.ID)))
+			}
+		})
+
+		It("jsonl formatted report should be empty without issues", func() {
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{}, &gosec.Metrics{}, map[string][]gosec.Error{})
+			buf := new(bytes.Buffer)
+			Expect(CreateReport(buf, "jsonl", false, []string{}, reportInfo)).To(Succeed())
+			Expect(buf.String()).To(BeEmpty())
+		})
+
 		It("json formatted report should contain the suppressed issues", func() {
 			errors := map[string][]gosec.Error{}
 			reportInfo := gosec.NewReportInfo([]*issue.Issue{&suppressedIssue}, &gosec.Metrics{}, errors)
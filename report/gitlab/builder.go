@@ -0,0 +1,43 @@
+package gitlab
+
+// NewLocation instantiate a Location
+func NewLocation(file string, startLine int, endLine int) *Location {
+	return &Location{
+		File:      file,
+		StartLine: startLine,
+		EndLine:   endLine,
+	}
+}
+
+// NewIdentifier instantiate an Identifier
+func NewIdentifier(identifierType string, name string, value string, url string) *Identifier {
+	return &Identifier{
+		Type:  identifierType,
+		Name:  name,
+		Value: value,
+		URL:   url,
+	}
+}
+
+// NewTool instantiate a Tool
+func NewTool(version string) *Tool {
+	return &Tool{
+		ID:      ScannerID,
+		Name:    ScannerName,
+		URL:     ScannerURL,
+		Version: version,
+		Vendor:  Vendor{Name: VendorName},
+	}
+}
+
+// NewScan instantiate a Scan
+func NewScan(version string, startTime string, endTime string) *Scan {
+	return &Scan{
+		Analyzer:  NewTool(version),
+		Scanner:   NewTool(version),
+		Type:      Category,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Status:    "success",
+	}
+}
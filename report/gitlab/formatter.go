@@ -0,0 +1,109 @@
+package gitlab
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/baseline"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const (
+	// Version of the GitLab security report schema
+	Version = "15.0.7"
+	// Category of the vulnerabilities reported by gosec
+	Category = "sast"
+	// ScannerID identifies gosec in the GitLab reports
+	ScannerID = "gosec"
+	// ScannerName the name of the scanner
+	ScannerName = "gosec"
+	// ScannerURL the URL of the scanner
+	ScannerURL = "https://github.com/securego/gosec"
+	// VendorName the name of the scanner vendor
+	VendorName = "Securego"
+	// TimeFormat the format of the scan start and end times
+	TimeFormat = "2006-01-02T15:04:05"
+)
+
+// GenerateReport converts a gosec report into a GitLab SAST report
+func GenerateReport(rootPaths []string, data *gosec.ReportInfo) (*Report, error) {
+	startTime := time.Now().UTC().Format(TimeFormat)
+	vulnerabilities := []*Vulnerability{}
+	for _, issue := range data.Issues {
+		location, err := parseLocation(issue, rootPaths)
+		if err != nil {
+			return nil, err
+		}
+		fingerprint := baseline.FingerprintPath(issue, location.File)
+		vulnerabilities = append(vulnerabilities, &Vulnerability{
+			ID:          uuid.NewSHA1(uuid.NameSpaceURL, []byte(fingerprint)).String(),
+			Category:    Category,
+			Name:        issue.What,
+			Message:     issue.What,
+			Description: fmt.Sprintf("[%s] %s", issue.RuleID, issue.What),
+			CVE:         fingerprint,
+			Severity:    getGitLabSeverity(issue.Severity.String()),
+			Scanner:     &Scanner{ID: ScannerID, Name: ScannerName},
+			Location:    location,
+			Identifiers: parseIdentifiers(issue),
+		})
+	}
+	endTime := time.Now().UTC().Format(TimeFormat)
+	return &Report{
+		Version:         Version,
+		Vulnerabilities: vulnerabilities,
+		Scan:            NewScan(data.GosecVersion, startTime, endTime),
+	}, nil
+}
+
+func parseLocation(issue *issue.Issue, rootPaths []string) (*Location, error) {
+	file := issue.File
+	for _, rootPath := range rootPaths {
+		if rel, err := filepath.Rel(rootPath, issue.File); err == nil && !strings.HasPrefix(rel, "..") {
+			file = filepath.ToSlash(rel)
+			break
+		}
+	}
+	lines := strings.Split(issue.Line, "-")
+	startLine, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return nil, err
+	}
+	endLine := startLine
+	if len(lines) > 1 {
+		endLine, err = strconv.Atoi(lines[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewLocation(file, startLine, endLine), nil
+}
+
+func parseIdentifiers(issue *issue.Issue) []*Identifier {
+	identifiers := []*Identifier{
+		NewIdentifier("gosec_rule_id", fmt.Sprintf("Gosec Rule ID %s", issue.RuleID), issue.RuleID, ""),
+	}
+	if issue.Cwe != nil {
+		identifiers = append(identifiers, NewIdentifier("cwe", issue.Cwe.SprintID(), issue.Cwe.ID, issue.Cwe.SprintURL()))
+	}
+	return identifiers
+}
+
+func getGitLabSeverity(s string) string {
+	switch s {
+	case "LOW":
+		return "Low"
+	case "MEDIUM":
+		return "Medium"
+	case "HIGH":
+		return "High"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,115 @@
+package gitlab_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/gitlab"
+)
+
+func newReportInfo() *gosec.ReportInfo {
+	return gosec.NewReportInfo([]*issue.Issue{
+		{
+			Severity:   issue.High,
+			Confidence: issue.Low,
+			Cwe:        issue.GetCweByRule("G101"),
+			RuleID:     "G101",
+			What:       "Potential hardcoded credentials",
+			File:       "/home/src/project/main.go",
+			Code:       "4: password := \"f62e5bcda4fae4f82370da0c6f20697b8f8447ef\"",
+			Line:       "4",
+			Col:        "2",
+		},
+		{
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			Cwe:        issue.GetCweByRule("G304"),
+			RuleID:     "G304",
+			What:       "Potential file inclusion via variable",
+			File:       "/home/src/project/pkg/file.go",
+			Code:       "10: os.ReadFile(path)\n11: ",
+			Line:       "10-11",
+			Col:        "9",
+		},
+	}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.20.0")
+}
+
+var _ = Describe("GitLab Formatter", func() {
+	Context("when converting to GitLab SAST report", func() {
+		It("it should match the golden report", func() {
+			report, err := gitlab.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			report.Scan.StartTime = "2024-01-01T00:00:00"
+			report.Scan.EndTime = "2024-01-01T00:00:01"
+			raw, err := json.MarshalIndent(report, "", "\t")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			golden, err := os.ReadFile(filepath.Join("testdata", "report.json"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(string(bytes.TrimSpace(golden))))
+		})
+
+		It("it should contain the fields required by the schema", func() {
+			buf := new(bytes.Buffer)
+			err := gitlab.WriteReport(buf, newReportInfo(), []string{"/home/src/project"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var report map[string]interface{}
+			Expect(json.Unmarshal(buf.Bytes(), &report)).To(Succeed())
+			Expect(report).To(HaveKeyWithValue("version", gitlab.Version))
+			Expect(report).To(HaveKey("scan"))
+			scan := report["scan"].(map[string]interface{})
+			Expect(scan).To(HaveKeyWithValue("type", "sast"))
+			Expect(scan).To(HaveKeyWithValue("status", "success"))
+			Expect(scan).To(HaveKey("start_time"))
+			Expect(scan).To(HaveKey("end_time"))
+
+			vulnerabilities := report["vulnerabilities"].([]interface{})
+			Expect(vulnerabilities).To(HaveLen(2))
+			vulnerability := vulnerabilities[0].(map[string]interface{})
+			Expect(vulnerability).To(HaveKeyWithValue("category", "sast"))
+			Expect(vulnerability).To(HaveKeyWithValue("severity", "High"))
+			Expect(vulnerability["scanner"]).To(HaveKeyWithValue("id", "gosec"))
+			Expect(vulnerability["location"]).To(HaveKeyWithValue("file", "main.go"))
+			Expect(vulnerability["location"]).To(HaveKeyWithValue("start_line", BeEquivalentTo(4)))
+			identifiers := vulnerability["identifiers"].([]interface{})
+			Expect(identifiers).To(ContainElement(HaveKeyWithValue("value", "G101")))
+			Expect(identifiers).To(ContainElement(HaveKeyWithValue("value", "798")))
+		})
+
+		It("it should generate a deterministic fingerprint", func() {
+			first, err := gitlab.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := gitlab.GenerateReport([]string{"/home/src/project"}, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			for i := range first.Vulnerabilities {
+				Expect(first.Vulnerabilities[i].ID).To(Equal(second.Vulnerabilities[i].ID))
+				Expect(first.Vulnerabilities[i].CVE).To(Equal(second.Vulnerabilities[i].CVE))
+			}
+			Expect(first.Vulnerabilities[0].CVE).NotTo(Equal(first.Vulnerabilities[1].CVE))
+
+			// the fingerprint does not depend on the location of the project
+			moved := newReportInfo()
+			moved.Issues[0].File = "/tmp/checkout/main.go"
+			third, err := gitlab.GenerateReport([]string{"/tmp/checkout"}, moved)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(third.Vulnerabilities[0].CVE).To(Equal(first.Vulnerabilities[0].CVE))
+
+			// nor on the line of the issue
+			shifted := newReportInfo()
+			shifted.Issues[0].Code = "6: password := \"f62e5bcda4fae4f82370da0c6f20697b8f8447ef\""
+			shifted.Issues[0].Line = "6"
+			fourth, err := gitlab.GenerateReport([]string{"/home/src/project"}, shifted)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fourth.Vulnerabilities[0].CVE).To(Equal(first.Vulnerabilities[0].CVE))
+			Expect(fourth.Vulnerabilities[0].ID).To(Equal(first.Vulnerabilities[0].ID))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package gitlab
+
+// Vendor defines the vendor of a scanning tool
+type Vendor struct {
+	Name string `json:"name"`
+}
+
+// Tool defines the analyzer or the scanner which produced the report
+type Tool struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	URL     string `json:"url,omitempty"`
+	Version string `json:"version"`
+	Vendor  Vendor `json:"vendor"`
+}
+
+// Scan defines the scan which produced the report
+type Scan struct {
+	Analyzer  *Tool  `json:"analyzer"`
+	Scanner   *Tool  `json:"scanner"`
+	Type      string `json:"type"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Status    string `json:"status"`
+}
+
+// Scanner identifies the scanner which found a vulnerability
+type Scanner struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Location defines the location of a vulnerability in the source code
+type Location struct {
+	File      string `json:"file"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line,omitempty"`
+}
+
+// Identifier defines an identifier of a vulnerability such as the rule ID or the CWE
+type Identifier struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+// Vulnerability defines a vulnerability of the GitLab SAST report
+type Vulnerability struct {
+	ID          string        `json:"id"`
+	Category    string        `json:"category"`
+	Name        string        `json:"name"`
+	Message     string        `json:"message"`
+	Description string        `json:"description"`
+	CVE         string        `json:"cve"`
+	Severity    string        `json:"severity"`
+	Scanner     *Scanner      `json:"scanner"`
+	Location    *Location     `json:"location"`
+	Identifiers []*Identifier `json:"identifiers"`
+}
+
+// Report defines a GitLab SAST report
+type Report struct {
+	Version         string           `json:"version"`
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+	Scan            *Scan            `json:"scan"`
+}
@@ -0,0 +1,22 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/securego/gosec/v2"
+)
+
+// WriteReport write a report in GitLab SAST format to the output writer
+func WriteReport(w io.Writer, data *gosec.ReportInfo, rootPaths []string) error {
+	report, err := GenerateReport(rootPaths, data)
+	if err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	return err
+}
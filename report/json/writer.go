@@ -3,13 +3,20 @@ package json
 import (
 	"encoding/json"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
 )
 
-// WriteReport write a report in json format to the output writer
+// WriteReport write a report in json format to the output writer. The issues are sorted
+// by severity in descending order, then by file and by line.
 func WriteReport(w io.Writer, data *gosec.ReportInfo) error {
-	raw, err := json.MarshalIndent(data, "", "\t")
+	sorted := *data
+	sorted.Issues = sortIssues(data.Issues)
+	raw, err := json.MarshalIndent(&sorted, "", "\t")
 	if err != nil {
 		return err
 	}
@@ -17,3 +24,28 @@ func WriteReport(w io.Writer, data *gosec.ReportInfo) error {
 	_, err = w.Write(raw)
 	return err
 }
+
+// sortIssues returns a copy of the issues in a deterministic order
+func sortIssues(issues []*issue.Issue) []*issue.Issue {
+	if issues == nil {
+		return nil
+	}
+	sorted := make([]*issue.Issue, len(issues))
+	copy(sorted, issues)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Severity != sorted[j].Severity {
+			return sorted[i].Severity > sorted[j].Severity
+		}
+		if sorted[i].File != sorted[j].File {
+			return sorted[i].File < sorted[j].File
+		}
+		return startLine(sorted[i].Line) < startLine(sorted[j].Line)
+	})
+	return sorted
+}
+
+// startLine returns the first line of a line range such as 12-14
+func startLine(line string) int {
+	start, _ := strconv.Atoi(strings.Split(line, "-")[0])
+	return start
+}
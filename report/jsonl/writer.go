@@ -0,0 +1,20 @@
+package jsonl
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/securego/gosec/v2"
+)
+
+// WriteReport write a report in JSON Lines format to the output writer. Each issue
+// is written as a single JSON object terminated by a newline.
+func WriteReport(w io.Writer, data *gosec.ReportInfo) error {
+	enc := json.NewEncoder(w)
+	for _, issue := range data.Issues {
+		if err := enc.Encode(issue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -102,6 +102,82 @@ func (r *Result) WithLocations(locations ...*Location) *Result {
 	return r
 }
 
+// WithRelatedLocations define the current result's related locations
+func (r *Result) WithRelatedLocations(locations ...*Location) *Result {
+	r.RelatedLocations = locations
+	return r
+}
+
+// WithCodeFlows define the current result's code flows
+func (r *Result) WithCodeFlows(codeFlows ...*CodeFlow) *Result {
+	r.CodeFlows = codeFlows
+	return r
+}
+
+// WithFixes define the current result's fixes
+func (r *Result) WithFixes(fixes ...*Fix) *Result {
+	r.Fixes = fixes
+	return r
+}
+
+// NewFix instantiate a Fix
+func NewFix(description *Message, artifactChanges ...*ArtifactChange) *Fix {
+	return &Fix{
+		Description:     description,
+		ArtifactChanges: artifactChanges,
+	}
+}
+
+// NewArtifactChange instantiate an ArtifactChange
+func NewArtifactChange(artifactLocation *ArtifactLocation, replacements ...*Replacement) *ArtifactChange {
+	return &ArtifactChange{
+		ArtifactLocation: artifactLocation,
+		Replacements:     replacements,
+	}
+}
+
+// NewReplacement instantiate a Replacement
+func NewReplacement(deletedRegion *Region, insertedContent *ArtifactContent) *Replacement {
+	return &Replacement{
+		DeletedRegion:   deletedRegion,
+		InsertedContent: insertedContent,
+	}
+}
+
+// NewCodeFlow instantiate a CodeFlow
+func NewCodeFlow(threadFlows ...*ThreadFlow) *CodeFlow {
+	return &CodeFlow{
+		ThreadFlows: threadFlows,
+	}
+}
+
+// NewThreadFlow instantiate a ThreadFlow
+func NewThreadFlow(locations ...*ThreadFlowLocation) *ThreadFlow {
+	return &ThreadFlow{
+		Locations: locations,
+	}
+}
+
+// NewThreadFlowLocation instantiate a ThreadFlowLocation
+func NewThreadFlowLocation(location *Location, executionOrder int) *ThreadFlowLocation {
+	return &ThreadFlowLocation{
+		Location:       location,
+		ExecutionOrder: executionOrder,
+	}
+}
+
+// WithMessage define the current location's message
+func (l *Location) WithMessage(message *Message) *Location {
+	l.Message = message
+	return l
+}
+
+// WithID define the current location's identifier
+func (l *Location) WithID(id int) *Location {
+	l.Id = id
+	return l
+}
+
 // NewLocation instantiate a Location
 func NewLocation(physicalLocation *PhysicalLocation) *Location {
 	return &Location{
@@ -49,6 +49,22 @@ func GenerateReport(rootPaths []string, data *gosec.ReportInfo) (*Report, error)
 			buildSarifSuppressions(issue.Suppressions),
 		).WithLocations(location)
 
+		if len(issue.RelatedLocations) > 0 {
+			related, codeFlow, err := parseSarifTaintTrail(issue, location, rootPaths)
+			if err != nil {
+				return nil, err
+			}
+			result.WithRelatedLocations(related...).WithCodeFlows(codeFlow)
+		}
+
+		if issue.Autofix != nil {
+			fix, err := parseSarifFix(issue.Autofix, rootPaths)
+			if err != nil {
+				return nil, err
+			}
+			result.WithFixes(fix)
+		}
+
 		results = append(results, result)
 	}
 
@@ -88,10 +104,9 @@ func addRuleInOrder(rules []*ReportingDescriptor, rule *ReportingDescriptor) ([]
 
 // parseSarifRule return SARIF rule field struct
 func parseSarifRule(i *issue.Issue) *ReportingDescriptor {
-	cwe := issue.GetCweByRule(i.RuleID)
 	name := i.RuleID
-	if cwe != nil {
-		name = cwe.Name
+	if i.Cwe != nil {
+		name = i.Cwe.Name
 	}
 	return &ReportingDescriptor{
 		ID:               i.RuleID,
@@ -182,13 +197,59 @@ func parseSarifLocation(i *issue.Issue, rootPaths []string) (*Location, error) {
 }
 
 func parseSarifArtifactLocation(i *issue.Issue, rootPaths []string) *ArtifactLocation {
+	return NewArtifactLocation(relativeFilePath(i.File, rootPaths))
+}
+
+func relativeFilePath(file string, rootPaths []string) string {
 	var filePath string
 	for _, rootPath := range rootPaths {
-		if strings.HasPrefix(i.File, rootPath) {
-			filePath = strings.Replace(i.File, rootPath+"/", "", 1)
+		if strings.HasPrefix(file, rootPath) {
+			filePath = strings.Replace(file, rootPath+"/", "", 1)
+		}
+	}
+	return filePath
+}
+
+// parseSarifTaintTrail returns the related locations of the issue and the code flow going
+// from the source of the tainted value through the related locations to the issue location
+func parseSarifTaintTrail(i *issue.Issue, location *Location, rootPaths []string) ([]*Location, *CodeFlow, error) {
+	related := make([]*Location, 0, len(i.RelatedLocations))
+	steps := make([]*ThreadFlowLocation, 0, len(i.RelatedLocations)+1)
+	for idx, loc := range i.RelatedLocations {
+		line, err := strconv.Atoi(loc.Line)
+		if err != nil {
+			return nil, nil, err
 		}
+		col, err := strconv.Atoi(loc.Col)
+		if err != nil {
+			return nil, nil, err
+		}
+		artifactLocation := NewArtifactLocation(relativeFilePath(loc.File, rootPaths))
+		region := NewRegion(line, line, col, col, "go")
+		related = append(related, NewLocation(NewPhysicalLocation(artifactLocation, region)).
+			WithID(idx+1).
+			WithMessage(NewMessage(loc.Message)))
+		steps = append(steps, NewThreadFlowLocation(
+			NewLocation(NewPhysicalLocation(artifactLocation, region)).WithMessage(NewMessage(loc.Message)), idx+1))
 	}
-	return NewArtifactLocation(filePath)
+	steps = append(steps, NewThreadFlowLocation(location, len(steps)+1))
+	return related, NewCodeFlow(NewThreadFlow(steps...)), nil
+}
+
+// parseSarifFix returns the fix replacing the region of the suggested change with its replacement text
+func parseSarifFix(f *issue.Fix, rootPaths []string) (*Fix, error) {
+	var positions [4]int
+	for idx, value := range []string{f.Line, f.Col, f.EndLine, f.EndCol} {
+		position, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		positions[idx] = position
+	}
+	region := NewRegion(positions[0], positions[2], positions[1], positions[3], "go")
+	artifactLocation := NewArtifactLocation(relativeFilePath(f.File, rootPaths))
+	replacement := NewReplacement(region, NewArtifactContent(f.Replacement))
+	return NewFix(NewMessage(f.Description), NewArtifactChange(artifactLocation, replacement)), nil
 }
 
 func parseSarifRegion(i *issue.Issue) (*Region, error) {
@@ -208,6 +269,15 @@ func parseSarifRegion(i *issue.Issue) (*Region, error) {
 	if err != nil {
 		return nil, err
 	}
+	endCol := col
+	if i.EndLine != "" && i.EndCol != "" {
+		if endLine, err = strconv.Atoi(i.EndLine); err != nil {
+			return nil, err
+		}
+		if endCol, err = strconv.Atoi(i.EndCol); err != nil {
+			return nil, err
+		}
+	}
 	var code string
 	line := startLine
 	codeLines := strings.Split(i.Code, "\n")
@@ -226,7 +296,7 @@ func parseSarifRegion(i *issue.Issue) (*Region, error) {
 		}
 	}
 	snippet := NewArtifactContent(code)
-	return NewRegion(startLine, endLine, col, col, "go").WithSnippet(snippet), nil
+	return NewRegion(startLine, endLine, col, endCol, "go").WithSnippet(snippet), nil
 }
 
 func getSarifLevel(s string) Level {
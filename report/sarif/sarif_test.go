@@ -112,6 +112,28 @@ var _ = Describe("Sarif Formatter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(sarifReport.Runs[0].Results[0].Locations[0].PhysicalLocation.Region.Snippet.Text).Should(Equal(expectedCode))
 		})
+		It("sarif formatted report should contain the full region of the issue", func() {
+			newissue := issue.Issue{
+				File:       "/home/src/project/test.go",
+				Line:       "69-70",
+				Col:        "14",
+				EndLine:    "70",
+				EndCol:     "9",
+				RuleID:     "G101",
+				What:       "test",
+				Confidence: issue.High,
+				Severity:   issue.High,
+				Code:       "69: var data = template.HTML(v.TmplFile)\n70: isTmpl\n",
+			}
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{&newissue}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.7.0")
+			sarifReport, err := sarif.GenerateReport([]string{}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+			region := sarifReport.Runs[0].Results[0].Locations[0].PhysicalLocation.Region
+			Expect(region.StartLine).Should(Equal(69))
+			Expect(region.StartColumn).Should(Equal(14))
+			Expect(region.EndLine).Should(Equal(70))
+			Expect(region.EndColumn).Should(Equal(9))
+		})
 		It("sarif formatted report should have proper rule index", func() {
 			rules := []string{"G404", "G101", "G102", "G103"}
 			issues := []*issue.Issue{}
@@ -172,5 +194,124 @@ var _ = Describe("Sarif Formatter", func() {
 			}
 			Expect(resultRuleIndexes).Should(Equal(driverRuleIndexes))
 		})
+
+		It("sarif formatted report should contain the related locations and the code flow", func() {
+			ruleID := "G204"
+			taintedIssue := issue.Issue{
+				File:       "/home/src/project/test.go",
+				Line:       "13",
+				Col:        "9",
+				RuleID:     ruleID,
+				What:       "Subprocess launched with variable",
+				Confidence: issue.High,
+				Severity:   issue.Medium,
+				Code:       "13: cmd := exec.Command(run, \"5\")",
+				Cwe:        issue.GetCweByRule(ruleID),
+				RelatedLocations: []issue.Location{
+					{File: "/home/src/project/test.go", Line: "11", Col: "2", Message: "Source of the tainted value"},
+					{File: "/home/src/project/test.go", Line: "12", Col: "2", Message: "Tainted value propagated"},
+				},
+			}
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{&taintedIssue}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.7.0")
+			sarifReport, err := sarif.GenerateReport([]string{"/home/src/project"}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			result := sarifReport.Runs[0].Results[0]
+			Expect(result.RelatedLocations).To(HaveLen(2))
+			Expect(result.RelatedLocations[0].Id).To(Equal(1))
+			Expect(result.RelatedLocations[0].Message.Text).To(Equal("Source of the tainted value"))
+			Expect(result.RelatedLocations[0].PhysicalLocation.ArtifactLocation.URI).To(Equal("test.go"))
+			Expect(result.RelatedLocations[0].PhysicalLocation.Region.StartLine).To(Equal(11))
+			Expect(result.RelatedLocations[1].PhysicalLocation.Region.StartLine).To(Equal(12))
+
+			Expect(result.CodeFlows).To(HaveLen(1))
+			Expect(result.CodeFlows[0].ThreadFlows).To(HaveLen(1))
+			steps := result.CodeFlows[0].ThreadFlows[0].Locations
+			Expect(steps).To(HaveLen(3))
+			Expect(steps[0].Location.PhysicalLocation.Region.StartLine).To(Equal(11))
+			Expect(steps[1].Location.PhysicalLocation.Region.StartLine).To(Equal(12))
+			Expect(steps[2].Location).To(Equal(result.Locations[0]))
+			Expect(steps[2].ExecutionOrder).To(Equal(3))
+		})
+
+		It("sarif formatted report should not contain a code flow without related locations", func() {
+			ruleID := "G101"
+			plainIssue := issue.Issue{
+				File:       "/home/src/project/test.go",
+				Line:       "1",
+				Col:        "1",
+				RuleID:     ruleID,
+				What:       "test",
+				Confidence: issue.High,
+				Severity:   issue.High,
+				Code:       "1: testcode",
+				Cwe:        issue.GetCweByRule(ruleID),
+			}
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{&plainIssue}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.7.0")
+			sarifReport, err := sarif.GenerateReport([]string{}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sarifReport.Runs[0].Results[0].RelatedLocations).To(BeEmpty())
+			Expect(sarifReport.Runs[0].Results[0].CodeFlows).To(BeEmpty())
+		})
+
+		It("sarif formatted report should contain the suggested fix", func() {
+			ruleID := "G501"
+			fixedIssue := issue.Issue{
+				File:       "/home/src/project/test.go",
+				Line:       "4",
+				Col:        "2",
+				RuleID:     ruleID,
+				What:       "Blocklisted import crypto/md5: weak cryptographic primitive",
+				Confidence: issue.High,
+				Severity:   issue.Medium,
+				Code:       "4: \"crypto/md5\"",
+				Cwe:        issue.GetCweByRule(ruleID),
+				Autofix: &issue.Fix{
+					Description: "Import crypto/sha256 instead of crypto/md5",
+					File:        "/home/src/project/test.go",
+					Line:        "4",
+					Col:         "2",
+					EndLine:     "4",
+					EndCol:      "14",
+					Replacement: "\"crypto/sha256\"",
+				},
+			}
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{&fixedIssue}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.7.0")
+			sarifReport, err := sarif.GenerateReport([]string{"/home/src/project"}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fixes := sarifReport.Runs[0].Results[0].Fixes
+			Expect(fixes).To(HaveLen(1))
+			Expect(fixes[0].Description.Text).To(Equal("Import crypto/sha256 instead of crypto/md5"))
+			Expect(fixes[0].ArtifactChanges).To(HaveLen(1))
+			change := fixes[0].ArtifactChanges[0]
+			Expect(change.ArtifactLocation.URI).To(Equal("test.go"))
+			Expect(change.Replacements).To(HaveLen(1))
+			region := change.Replacements[0].DeletedRegion
+			Expect(region.StartLine).To(Equal(4))
+			Expect(region.StartColumn).To(Equal(2))
+			Expect(region.EndLine).To(Equal(4))
+			Expect(region.EndColumn).To(Equal(14))
+			Expect(change.Replacements[0].InsertedContent.Text).To(Equal("\"crypto/sha256\""))
+		})
+
+		It("sarif formatted report should not contain fixes without a suggested fix", func() {
+			ruleID := "G101"
+			plainIssue := issue.Issue{
+				File:       "/home/src/project/test.go",
+				Line:       "1",
+				Col:        "1",
+				RuleID:     ruleID,
+				What:       "test",
+				Confidence: issue.High,
+				Severity:   issue.High,
+				Code:       "1: testcode",
+				Cwe:        issue.GetCweByRule(ruleID),
+			}
+			reportInfo := gosec.NewReportInfo([]*issue.Issue{&plainIssue}, &gosec.Metrics{}, map[string][]gosec.Error{}).WithVersion("v2.7.0")
+			sarifReport, err := sarif.GenerateReport([]string{}, reportInfo)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sarifReport.Runs[0].Results[0].Fixes).To(BeEmpty())
+		})
 	})
 })
@@ -63,6 +63,13 @@ func parseTextRange(issue *issue.Issue) (*TextRange, error) {
 	return NewTextRange(startLine, endLine), nil
 }
 
+// getSonarSeverity maps the gosec severity to the SonarQube severity:
+//
+//	HIGH   -> BLOCKER
+//	MEDIUM -> MAJOR
+//	LOW    -> MINOR
+//
+// Any other value is reported as INFO.
 func getSonarSeverity(s string) string {
 	switch s {
 	case "LOW":
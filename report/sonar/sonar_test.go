@@ -1,6 +1,10 @@
 package sonar_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -211,5 +215,63 @@ var _ = Describe("Sonar Formatter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(*issues).To(Equal(*want))
 		})
+
+		It("it should write the report matching the generic issue import format", func() {
+			data := &gosec.ReportInfo{
+				Errors: map[string][]gosec.Error{},
+				Issues: []*issue.Issue{
+					{
+						Severity:   issue.High,
+						Confidence: issue.Low,
+						RuleID:     "G101",
+						What:       "Potential hardcoded credentials",
+						File:       "/home/src/project/main.go",
+						Code:       "",
+						Line:       "4",
+					},
+					{
+						Severity:   issue.Medium,
+						Confidence: issue.High,
+						RuleID:     "G304",
+						What:       "Potential file inclusion via variable",
+						File:       "/home/src/project/pkg/file.go",
+						Code:       "",
+						Line:       "10-11",
+					},
+				},
+				Stats: &gosec.Metrics{},
+			}
+			buf := new(bytes.Buffer)
+			err := sonar.WriteReport(buf, data, []string{"/home/src/project"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			golden, err := os.ReadFile(filepath.Join("testdata", "report.json"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(string(bytes.TrimSpace(golden))))
+		})
+
+		DescribeTable("it should map the gosec severity to the SonarQube severity",
+			func(severity issue.Score, expected string) {
+				data := &gosec.ReportInfo{
+					Issues: []*issue.Issue{
+						{
+							Severity: severity,
+							RuleID:   "test",
+							What:     "test",
+							File:     "/home/src/project/test.go",
+							Line:     "1",
+						},
+					},
+				}
+				report, err := sonar.GenerateReport([]string{"/home/src/project"}, data)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(report.Issues).To(HaveLen(1))
+				Expect(report.Issues[0].Severity).To(Equal(expected))
+				Expect(report.Issues[0].Type).To(Equal("VULNERABILITY"))
+			},
+			Entry("HIGH", issue.High, "BLOCKER"),
+			Entry("MEDIUM", issue.Medium, "MAJOR"),
+			Entry("LOW", issue.Low, "MINOR"),
+		)
 	})
 })
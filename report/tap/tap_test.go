@@ -0,0 +1,132 @@
+package tap_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/report/tap"
+)
+
+func newReportInfo() *gosec.ReportInfo {
+	return gosec.NewReportInfo([]*issue.Issue{
+		{
+			Severity:   issue.High,
+			Confidence: issue.Low,
+			Cwe:        issue.GetCweByRule("G101"),
+			RuleID:     "G101",
+			What:       "Potential hardcoded credentials",
+			File:       "/home/src/project/main.go",
+			Line:       "4",
+			Col:        "2",
+		},
+		{
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			Cwe:        issue.GetCweByRule("G304"),
+			RuleID:     "G304",
+			What:       "Potential file inclusion via variable",
+			File:       "/home/src/project/pkg/file.go",
+			Line:       "10-11",
+			Col:        "9",
+		},
+		{
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			RuleID:     "G104",
+			What:       "Errors unhandled: #1",
+			File:       "/home/src/project/main.go",
+			Line:       "12",
+			Col:        "3",
+		},
+	}, &gosec.Metrics{}, map[string][]gosec.Error{})
+}
+
+// diagnostics extracts the YAML blocks of the TAP stream
+func diagnostics(stream string) []string {
+	var blocks []string
+	var block []string
+	inBlock := false
+	for _, line := range strings.Split(stream, "\n") {
+		switch {
+		case line == "  ---":
+			inBlock = true
+			block = nil
+		case line == "  ...":
+			inBlock = false
+			blocks = append(blocks, strings.Join(block, "\n"))
+		case inBlock:
+			block = append(block, strings.TrimPrefix(line, "  "))
+		}
+	}
+	return blocks
+}
+
+var _ = Describe("TAP Formatter", func() {
+	Context("when converting to TAP stream", func() {
+		It("it should match the golden report", func() {
+			buf := new(bytes.Buffer)
+			err := tap.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			golden, err := os.ReadFile(filepath.Join("testdata", "report.tap"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(string(golden)))
+		})
+
+		It("it should plan one test point per issue", func() {
+			buf := new(bytes.Buffer)
+			err := tap.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			plan := regexp.MustCompile(`(?m)^1\.\.(\d+)$`).FindStringSubmatch(buf.String())
+			Expect(plan).To(HaveLen(2))
+			Expect(plan[1]).To(Equal("3"))
+			Expect(regexp.MustCompile(`(?m)^not ok \d+ - `).FindAllString(buf.String(), -1)).To(HaveLen(3))
+		})
+
+		It("it should produce diagnostics which parse as YAML", func() {
+			buf := new(bytes.Buffer)
+			err := tap.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			blocks := diagnostics(buf.String())
+			Expect(blocks).To(HaveLen(3))
+			diagnostic := tap.Diagnostic{}
+			Expect(yaml.Unmarshal([]byte(blocks[0]), &diagnostic)).To(Succeed())
+			Expect(diagnostic).To(Equal(tap.Diagnostic{
+				File:       "/home/src/project/main.go",
+				Line:       "4",
+				Column:     "2",
+				Severity:   "HIGH",
+				Confidence: "LOW",
+				CWE:        "CWE-798",
+			}))
+			for _, block := range blocks[1:] {
+				Expect(yaml.Unmarshal([]byte(block), &tap.Diagnostic{})).To(Succeed())
+			}
+		})
+
+		It("it should escape the directive character in the description", func() {
+			buf := new(bytes.Buffer)
+			err := tap.WriteReport(buf, newReportInfo())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring(`not ok 3 - G104: Errors unhandled: \#1`))
+		})
+
+		It("it should emit an empty plan when there are no issues", func() {
+			buf := new(bytes.Buffer)
+			err := tap.WriteReport(buf, gosec.NewReportInfo([]*issue.Issue{}, &gosec.Metrics{}, map[string][]gosec.Error{}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("TAP version 13\n1..0\n"))
+		})
+	})
+})
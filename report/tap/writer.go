@@ -0,0 +1,69 @@
+package tap
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// Version of the TAP specification
+const Version = 13
+
+// Diagnostic is the YAML block attached to each failed test point
+type Diagnostic struct {
+	File       string `yaml:"file"`
+	Line       string `yaml:"line"`
+	Column     string `yaml:"column,omitempty"`
+	Severity   string `yaml:"severity"`
+	Confidence string `yaml:"confidence"`
+	CWE        string `yaml:"cwe,omitempty"`
+}
+
+// WriteReport write a report in TAP format to the output writer. Every issue is reported
+// as a failed test point followed by a YAML diagnostic block.
+func WriteReport(w io.Writer, data *gosec.ReportInfo) error {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "TAP version %d\n", Version)
+	fmt.Fprintf(buf, "1..%d\n", len(data.Issues))
+	for i, issue := range data.Issues {
+		fmt.Fprintf(buf, "not ok %d - %s: %s\n", i+1, issue.RuleID, escape(issue.What))
+		diagnostic, err := yaml.Marshal(newDiagnostic(issue))
+		if err != nil {
+			return err
+		}
+		buf.WriteString("  ---\n")
+		for _, line := range strings.Split(strings.TrimSuffix(string(diagnostic), "\n"), "\n") {
+			fmt.Fprintf(buf, "  %s\n", line)
+		}
+		buf.WriteString("  ...\n")
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func newDiagnostic(i *issue.Issue) *Diagnostic {
+	diagnostic := &Diagnostic{
+		File:       i.File,
+		Line:       i.Line,
+		Column:     i.Col,
+		Severity:   i.Severity.String(),
+		Confidence: i.Confidence.String(),
+	}
+	if i.Cwe != nil && i.Cwe.ID != "" {
+		diagnostic.CWE = i.Cwe.SprintID()
+	}
+	return diagnostic
+}
+
+// escape keeps the description on a single line and prevents a '#' from being
+// interpreted as the start of a TAP directive
+func escape(description string) string {
+	description = strings.ReplaceAll(description, "\n", " ")
+	return strings.ReplaceAll(description, "#", `\#`)
+}
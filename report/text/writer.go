@@ -6,6 +6,7 @@ import (
 	_ "embed" // use go embed to import template
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -20,13 +21,64 @@ var (
 	errorTheme   = color.New(color.FgLightWhite, color.BgRed)
 	warningTheme = color.New(color.FgBlack, color.BgYellow)
 	defaultTheme = color.New(color.FgWhite, color.BgBlack)
+	ruleTheme    = color.New(color.FgCyan, color.OpBold)
 
 	//go:embed template.txt
 	templateContent string
 )
 
+// GroupBy defines how the issues are organized in the text report
+type GroupBy string
+
+const (
+	// GroupByNone lists the issues in the order in which they are reported
+	GroupByNone GroupBy = ""
+	// GroupBySeverity groups the issues by severity, from the highest to the lowest
+	GroupBySeverity GroupBy = "severity"
+	// GroupByFile groups the issues by file
+	GroupByFile GroupBy = "file"
+	// GroupByRule groups the issues by rule ID
+	GroupByRule GroupBy = "rule"
+)
+
+// Options customizes the text report
+type Options struct {
+	// GroupBy organizes the issues in sections
+	GroupBy GroupBy
+	// CWELinks adds the URL of the CWE entry below each issue mapped to a CWE
+	CWELinks bool
+}
+
+// issueGroup is a section of the report with the issues sharing the same severity, file or rule
+type issueGroup struct {
+	Title  string
+	Issues []*issue.Issue
+}
+
+// textReport is the data rendered by the template
+type textReport struct {
+	*gosec.ReportInfo
+	Groups   []*issueGroup
+	CWELinks bool
+}
+
 // WriteReport write a (colorized) report in text format
 func WriteReport(w io.Writer, data *gosec.ReportInfo, enableColor bool) error {
+	return WriteGroupedReport(w, data, enableColor, GroupByNone)
+}
+
+// WriteGroupedReport write a (colorized) report in text format with the issues organized in
+// sections according to the given grouping
+func WriteGroupedReport(w io.Writer, data *gosec.ReportInfo, enableColor bool, groupBy GroupBy) error {
+	return WriteReportWithOptions(w, data, enableColor, Options{GroupBy: groupBy})
+}
+
+// WriteReportWithOptions write a (colorized) report in text format customized with the given options
+func WriteReportWithOptions(w io.Writer, data *gosec.ReportInfo, enableColor bool, opts Options) error {
+	groups, err := groupIssues(data.Issues, opts.GroupBy)
+	if err != nil {
+		return err
+	}
 	t, e := template.
 		New("gosec").
 		Funcs(plainTextFuncMap(enableColor)).
@@ -35,16 +87,57 @@ func WriteReport(w io.Writer, data *gosec.ReportInfo, enableColor bool) error {
 		return e
 	}
 
-	return t.Execute(w, data)
+	return t.Execute(w, &textReport{ReportInfo: data, Groups: groups, CWELinks: opts.CWELinks})
+}
+
+func groupIssues(issues []*issue.Issue, groupBy GroupBy) ([]*issueGroup, error) {
+	var key func(i *issue.Issue) string
+	switch groupBy {
+	case GroupByNone:
+		return []*issueGroup{{Issues: issues}}, nil
+	case GroupBySeverity:
+		key = func(i *issue.Issue) string { return "Severity: " + i.Severity.String() }
+	case GroupByFile:
+		key = func(i *issue.Issue) string { return "File: " + i.File }
+	case GroupByRule:
+		key = func(i *issue.Issue) string { return "Rule: " + i.RuleID }
+	default:
+		return nil, fmt.Errorf("invalid grouping %q, valid options are: severity, file, rule", groupBy)
+	}
+
+	sorted := make([]*issue.Issue, len(issues))
+	copy(sorted, issues)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if groupBy == GroupBySeverity {
+			return sorted[i].Severity > sorted[j].Severity
+		}
+		return key(sorted[i]) < key(sorted[j])
+	})
+
+	var groups []*issueGroup
+	for _, i := range sorted {
+		title := key(i)
+		if len(groups) == 0 || groups[len(groups)-1].Title != title {
+			groups = append(groups, &issueGroup{Title: title})
+		}
+		group := groups[len(groups)-1]
+		group.Issues = append(group.Issues, i)
+	}
+	for _, group := range groups {
+		group.Title = fmt.Sprintf("%s (%d)", group.Title, len(group.Issues))
+	}
+	return groups, nil
 }
 
 func plainTextFuncMap(enableColor bool) template.FuncMap {
 	if enableColor {
 		return template.FuncMap{
 			"highlight": highlight,
-			"danger":    color.Danger.Render,
-			"notice":    color.Notice.Render,
-			"success":   color.Success.Render,
+			"rule":      render(ruleTheme),
+			"severity":  severity,
+			"danger":    render(color.Danger.Style),
+			"notice":    render(color.Notice.Style),
+			"success":   render(color.Success.Style),
 			"printCode": printCodeSnippet,
 		}
 	}
@@ -54,6 +147,8 @@ func plainTextFuncMap(enableColor bool) template.FuncMap {
 		"highlight": func(t string, s issue.Score, ignored bool) string {
 			return t
 		},
+		"rule":      fmt.Sprint,
+		"severity":  issue.Score.String,
 		"danger":    fmt.Sprint,
 		"notice":    fmt.Sprint,
 		"success":   fmt.Sprint,
@@ -61,18 +156,36 @@ func plainTextFuncMap(enableColor bool) template.FuncMap {
 	}
 }
 
+// render returns a function which colors the content with the given style. The colors are
+// applied regardless of the terminal capabilities, since the caller already decided to
+// enable them.
+func render(style color.Style) func(a ...interface{}) string {
+	return func(a ...interface{}) string {
+		return color.StartSet + style.String() + "m" + fmt.Sprint(a...) + color.ResetSet
+	}
+}
+
 // highlight returns content t colored based on Score
 func highlight(t string, s issue.Score, ignored bool) string {
 	if ignored {
-		return defaultTheme.Sprint(t)
+		return render(defaultTheme)(t)
 	}
+	return render(scoreTheme(s))(t)
+}
+
+// severity returns the severity label colored based on its value
+func severity(s issue.Score) string {
+	return render(scoreTheme(s))(s.String())
+}
+
+func scoreTheme(s issue.Score) color.Style {
 	switch s {
 	case issue.High:
-		return errorTheme.Sprint(t)
+		return errorTheme
 	case issue.Medium:
-		return warningTheme.Sprint(t)
+		return warningTheme
 	default:
-		return defaultTheme.Sprint(t)
+		return defaultTheme
 	}
 }
 
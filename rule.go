@@ -19,7 +19,9 @@ import (
 	"github.com/securego/gosec/v2/issue"
 )
 
-// The Rule interface used by all rules supported by gosec.
+// The Rule interface used by all rules supported by gosec. A rule instance is
+// only used by a single goroutine, hence a rule may keep some state between the
+// calls to Match. The analyzer builds new instances for each concurrent worker.
 type Rule interface {
 	ID() string
 	Match(ast.Node, *Context) (*issue.Issue, error)
@@ -18,7 +18,7 @@ func (a *archive) ID() string {
 	return a.MetaData.ID
 }
 
-// Match inspects AST nodes to determine if the filepath.Joins uses any argument derived from type zip.File or tar.Header
+// Match inspects AST nodes to determine if the filepath.Joins uses any argument derived from type zip.File
 func (a *archive) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 	if node := a.calls.ContainsPkgCallExpr(n, c, false); node != nil {
 		for _, arg := range node.Args {
@@ -48,19 +48,140 @@ func (a *archive) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 	return nil, nil
 }
 
-// NewArchive creates a new rule which detects the file traversal when extracting zip/tar archives
+// NewArchive creates a new rule which detects the file traversal when extracting zip archives.
+// The tar archives are covered by the tar archive rule.
 func NewArchive(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
 	calls := gosec.NewCallList()
 	calls.Add("path/filepath", "Join")
 	calls.Add("path", "Join")
 	return &archive{
 		calls:    calls,
-		argTypes: []string{"*archive/zip.File", "*archive/tar.Header"},
+		argTypes: []string{"*archive/zip.File"},
 		MetaData: issue.MetaData{
 			ID:         id,
 			Severity:   issue.Medium,
 			Confidence: issue.High,
-			What:       "File traversal when extracting zip/tar archive",
+			What:       "File traversal when extracting zip archive",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
+
+type tarArchive struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (a *tarArchive) ID() string {
+	return a.MetaData.ID
+}
+
+// isTarHeaderName checks if the expression is the name of a tar entry, either directly
+// or through a variable assigned from it
+func isTarHeaderName(expr ast.Expr, c *gosec.Context) bool {
+	if ident, ok := expr.(*ast.Ident); ok && ident.Obj != nil && ident.Obj.Kind == ast.Var {
+		if assign, ok := ident.Obj.Decl.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+			for i, lhs := range assign.Lhs {
+				if lhsIdent, ok := lhs.(*ast.Ident); ok && lhsIdent.Name == ident.Name {
+					expr = assign.Rhs[i]
+				}
+			}
+		}
+	}
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok || (selector.Sel.Name != "Name" && selector.Sel.Name != "Linkname") {
+		return false
+	}
+	argType := c.Info.TypeOf(selector.X)
+	return argType != nil && argType.String() == "*archive/tar.Header"
+}
+
+// resultVariable returns the variable holding the result of the call
+func resultVariable(call *ast.CallExpr, c *gosec.Context) types.Object {
+	var obj types.Object
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || obj != nil || len(assign.Lhs) != len(assign.Rhs) {
+			return obj == nil
+		}
+		for i, rhs := range assign.Rhs {
+			ast.Inspect(rhs, func(n ast.Node) bool {
+				if n == call {
+					if ident, ok := assign.Lhs[i].(*ast.Ident); ok {
+						obj = c.Info.ObjectOf(ident)
+					}
+				}
+				return obj == nil
+			})
+		}
+		return obj == nil
+	})
+	return obj
+}
+
+// isPrefixValidated checks if the path is verified to stay within the destination
+// directory with strings.HasPrefix, after being cleaned with filepath.Clean
+func isPrefixValidated(path types.Object, c *gosec.Context) bool {
+	validated := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		call, ok := gosec.MatchCallByPackage(n, c, "strings", "HasPrefix")
+		if !ok || len(call.Args) == 0 {
+			return !validated
+		}
+		arg := call.Args[0]
+		if clean, ok := gosec.MatchCallByPackage(arg, c, "path/filepath", "Clean"); ok && len(clean.Args) == 1 {
+			arg = clean.Args[0]
+		}
+		if ident, ok := arg.(*ast.Ident); ok && c.Info.ObjectOf(ident) == path {
+			validated = true
+		}
+		return !validated
+	})
+	return validated
+}
+
+// Match inspects AST nodes to determine if the name of a tar entry flows into a file path
+func (a *tarArchive) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	node := a.calls.ContainsPkgCallExpr(n, c, false)
+	if node == nil {
+		return nil, nil
+	}
+	_, name, _ := gosec.GetCallInfo(node, c)
+	args := node.Args
+	if name != "Join" && len(args) > 0 {
+		// os.Create and os.OpenFile take the path as first argument
+		args = args[:1]
+	}
+	for _, arg := range args {
+		if !isTarHeaderName(arg, c) {
+			continue
+		}
+		path := resultVariable(node, c)
+		if name != "Join" {
+			if ident, ok := arg.(*ast.Ident); ok {
+				path = c.Info.ObjectOf(ident)
+			}
+		}
+		if path != nil && isPrefixValidated(path, c) {
+			return nil, nil
+		}
+		return c.NewIssue(n, a.ID(), a.What, a.Severity, a.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewTarArchive creates a new rule which detects the file traversal when extracting tar archives
+func NewTarArchive(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("path/filepath", "Join")
+	calls.Add("path", "Join")
+	calls.AddAll("os", "Create", "OpenFile")
+	return &tarArchive{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "File traversal when extracting tar archive",
 		},
 	}, []ast.Node{(*ast.CallExpr)(nil)}
 }
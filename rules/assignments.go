@@ -0,0 +1,90 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+)
+
+// assignment is a value assigned to a variable by an assignment or a declaration
+type assignment struct {
+	node  ast.Node
+	value ast.Expr
+}
+
+// assignments returns the values assigned to the variable in the current file, in the
+// order of the source. The value is the call itself when the variable receives one of
+// the results of a call.
+func assignments(obj types.Object, c *gosec.Context) []assignment {
+	var found []assignment
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) && len(node.Rhs) != 1 {
+				return true
+			}
+			for i, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && c.Info.ObjectOf(ident) == obj {
+					if len(node.Lhs) == len(node.Rhs) {
+						found = append(found, assignment{node, node.Rhs[i]})
+					} else {
+						found = append(found, assignment{node, node.Rhs[0]})
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range node.Names {
+				if i < len(node.Values) && c.Info.ObjectOf(name) == obj {
+					found = append(found, assignment{node, node.Values[i]})
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// assignedValue returns the first value assigned to the variable in the current file
+func assignedValue(obj types.Object, c *gosec.Context) ast.Expr {
+	if found := assignments(obj, c); len(found) > 0 {
+		return found[0].value
+	}
+	return nil
+}
+
+// singleValue returns the value assigned to the variable when it is assigned only once
+// in the current file, since a reassigned variable may hold any of its values
+func singleValue(obj types.Object, c *gosec.Context) ast.Expr {
+	if found := assignments(obj, c); len(found) == 1 {
+		return found[0].value
+	}
+	return nil
+}
+
+// reachingValue returns the value of the last assignment to the variable which precedes
+// the given position, so a variable reassigned with a derived context resolves to it
+func reachingValue(obj types.Object, pos token.Pos, c *gosec.Context) ast.Expr {
+	var value ast.Expr
+	for _, a := range assignments(obj, c) {
+		if a.node.End() <= pos {
+			value = a.value
+		}
+	}
+	return value
+}
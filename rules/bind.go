@@ -17,6 +17,7 @@ package rules
 import (
 	"go/ast"
 	"regexp"
+	"strings"
 
 	"github.com/securego/gosec/v2"
 	"github.com/securego/gosec/v2/issue"
@@ -25,55 +26,90 @@ import (
 // Looks for net.Listen("0.0.0.0") or net.Listen(":8080")
 type bindsToAllNetworkInterfaces struct {
 	issue.MetaData
-	calls   gosec.CallList
-	pattern *regexp.Regexp
+	calls        gosec.CallList
+	pattern      *regexp.Regexp
+	loopback     *regexp.Regexp
+	loopbackSafe bool
 }
 
 func (r *bindsToAllNetworkInterfaces) ID() string {
 	return r.MetaData.ID
 }
 
-func (r *bindsToAllNetworkInterfaces) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
-	callExpr := r.calls.ContainsPkgCallExpr(n, c, false)
-	if callExpr == nil {
-		return nil, nil
-	}
+// addresses returns the values of the address passed to the listen call
+func (r *bindsToAllNetworkInterfaces) addresses(callExpr *ast.CallExpr, c *gosec.Context) []string {
 	if len(callExpr.Args) > 1 {
 		arg := callExpr.Args[1]
 		if bl, ok := arg.(*ast.BasicLit); ok {
 			if arg, err := gosec.GetString(bl); err == nil {
-				if r.pattern.MatchString(arg) {
-					return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
-				}
+				return []string{arg}
 			}
 		} else if ident, ok := arg.(*ast.Ident); ok {
-			values := gosec.GetIdentStringValues(ident)
-			for _, value := range values {
-				if r.pattern.MatchString(value) {
-					return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+			return gosec.GetIdentStringValues(ident)
+		}
+	} else if len(callExpr.Args) > 0 {
+		return gosec.GetCallStringArgsValues(callExpr.Args[0], c)
+	}
+	return nil
+}
+
+// servesDebugEndpoints checks if the package registers the pprof or the expvar handlers,
+// either by importing their packages or by serving the /debug/pprof paths
+func servesDebugEndpoints(c *gosec.Context) bool {
+	for _, imp := range c.Pkg.Imports() {
+		if imp.Path() == "net/http/pprof" || imp.Path() == "expvar" {
+			return true
+		}
+	}
+	found := false
+	for _, file := range c.PkgFiles {
+		ast.Inspect(file, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.BasicLit); ok {
+				if value, err := gosec.GetString(lit); err == nil && strings.HasPrefix(value, "/debug/pprof") {
+					found = true
 				}
 			}
+			return !found
+		})
+		if found {
+			return true
 		}
-	} else if len(callExpr.Args) > 0 {
-		values := gosec.GetCallStringArgsValues(callExpr.Args[0], c)
-		for _, value := range values {
-			if r.pattern.MatchString(value) {
-				return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return false
+}
+
+func (r *bindsToAllNetworkInterfaces) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	callExpr := r.calls.ContainsPkgCallExpr(n, c, false)
+	if callExpr == nil {
+		return nil, nil
+	}
+	for _, value := range r.addresses(callExpr, c) {
+		switch {
+		case r.pattern.MatchString(value):
+			if servesDebugEndpoints(c) {
+				return c.NewIssue(n, r.ID(), r.What+" and exposes the pprof or expvar debug endpoints", issue.High, r.Confidence), nil
 			}
+			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		case !r.loopbackSafe && r.loopback.MatchString(value) && servesDebugEndpoints(c):
+			return c.NewIssue(n, r.ID(), "Exposes the pprof or expvar debug endpoints on the loopback interface", issue.Low, issue.Medium), nil
 		}
 	}
 	return nil, nil
 }
 
 // NewBindsToAllNetworkInterfaces detects socket connections that are setup to
-// listen on all network interfaces.
-func NewBindsToAllNetworkInterfaces(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+// listen on all network interfaces. The severity is raised when the package also
+// serves the pprof or expvar debug endpoints. The servers of debug endpoints bound to
+// the loopback interface are reported as well when the "loopback_safe" setting is false.
+func NewBindsToAllNetworkInterfaces(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	calls := gosec.NewCallList()
 	calls.Add("net", "Listen")
 	calls.Add("crypto/tls", "Listen")
 	return &bindsToAllNetworkInterfaces{
-		calls:   calls,
-		pattern: regexp.MustCompile(`^(0.0.0.0|:).*$`),
+		calls:        calls,
+		pattern:      regexp.MustCompile(`^(0.0.0.0|:).*$`),
+		loopback:     regexp.MustCompile(`^(127\.|localhost:|\[::1\]:)`),
+		loopbackSafe: conf.RuleSettings(id).Bool("loopback_safe", true),
 		MetaData: issue.MetaData{
 			ID:         id,
 			Severity:   issue.Medium,
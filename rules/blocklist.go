@@ -39,7 +39,8 @@ func (r *blocklistedImport) ID() string {
 
 func (r *blocklistedImport) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 	if node, ok := n.(*ast.ImportSpec); ok {
-		if description, ok := r.Blocklisted[unquote(node.Path.Value)]; ok {
+		path := unquote(node.Path.Value)
+		if description, ok := r.Blocklisted[path]; ok {
 			return c.NewIssue(node, r.ID(), description, r.Severity, r.Confidence), nil
 		}
 	}
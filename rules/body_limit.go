@@ -0,0 +1,130 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unboundedBodyRead struct {
+	issue.MetaData
+	reads    gosec.CallList
+	limiters gosec.CallList
+}
+
+func (r *unboundedBodyRead) ID() string {
+	return r.MetaData.ID
+}
+
+// requestBody returns the request whose body is read by the expression, either directly
+// through r.Body or through a variable assigned from it
+func requestBody(expr ast.Expr, c *gosec.Context) types.Object {
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj := c.Info.ObjectOf(ident)
+		if obj == nil {
+			return nil
+		}
+		if expr = assignedValue(obj, c); expr == nil {
+			return nil
+		}
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Body" {
+		return nil
+	}
+	req, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	if t := c.Info.TypeOf(req); t == nil || (t.String() != "*net/http.Request" && t.String() != "net/http.Request") {
+		return nil
+	}
+	return c.Info.ObjectOf(req)
+}
+
+// limited checks if the body of the request is replaced by a limited reader, with
+// http.MaxBytesReader or io.LimitReader, before the read
+func (r *unboundedBodyRead) limited(req types.Object, read ast.Node, c *gosec.Context) bool {
+	body := enclosingFunc(read, c)
+	if body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found || n == nil || n.Pos() >= read.Pos() {
+			return false
+		}
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Body" || i >= len(assign.Rhs) {
+				continue
+			}
+			if ident, ok := sel.X.(*ast.Ident); !ok || c.Info.ObjectOf(ident) != req {
+				continue
+			}
+			ast.Inspect(assign.Rhs[i], func(node ast.Node) bool {
+				if r.limiters.ContainsPkgCallExpr(node, c, false) != nil {
+					found = true
+				}
+				return !found
+			})
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *unboundedBodyRead) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.reads.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) != 1 {
+		return nil, nil
+	}
+	req := requestBody(call.Args[0], c)
+	if req == nil || r.limited(req, n, c) {
+		return nil, nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewUnboundedBodyRead detects HTTP request bodies which are read entirely, with io.ReadAll
+// or with a JSON decoder, without being limited by http.MaxBytesReader or io.LimitReader,
+// which allows a client to exhaust the memory of the server.
+func NewUnboundedBodyRead(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	reads := gosec.NewCallList()
+	reads.Add("io", "ReadAll")
+	reads.Add("io/ioutil", "ReadAll")
+	reads.Add("encoding/json", "NewDecoder")
+	limiters := gosec.NewCallList()
+	limiters.Add("net/http", "MaxBytesReader")
+	limiters.Add("io", "LimitReader")
+	return &unboundedBodyRead{
+		reads:    reads,
+		limiters: limiters,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "HTTP request body read without a size limit",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,109 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type brokenCertVerify struct {
+	issue.MetaData
+}
+
+func (r *brokenCertVerify) ID() string {
+	return r.MetaData.ID
+}
+
+// isVerifyCallback checks if the field name is one of the tls.Config verification callbacks
+func isVerifyCallback(name string) bool {
+	return name == "VerifyPeerCertificate" || name == "VerifyConnection"
+}
+
+// alwaysReturnsNil checks if all the return statements of the function body return nil
+func alwaysReturnsNil(body *ast.BlockStmt) bool {
+	onlyNil := true
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(node.Results) != 1 {
+				onlyNil = false
+			} else if ident, ok := node.Results[0].(*ast.Ident); !ok || ident.Name != "nil" {
+				onlyNil = false
+			}
+		}
+		return onlyNil
+	})
+	return onlyNil
+}
+
+func (r *brokenCertVerify) checkCallback(n ast.Node, field string, value ast.Expr, c *gosec.Context) *issue.Issue {
+	if !isVerifyCallback(field) {
+		return nil
+	}
+	if _, body := resolveFunc(value, c); body != nil && alwaysReturnsNil(body) {
+		return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s always returns nil", r.What, field), r.Severity, r.Confidence)
+	}
+	return nil
+}
+
+func (r *brokenCertVerify) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CompositeLit:
+		if gosec.MatchCompLit(node, c, "crypto/tls.Config") == nil {
+			return nil, nil
+		}
+		for _, elt := range node.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok {
+					if issue := r.checkCallback(kv, key.Name, kv.Value, c); issue != nil {
+						return issue, nil
+					}
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		for i, lhs := range node.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok || i >= len(node.Rhs) {
+				continue
+			}
+			if t := c.Info.TypeOf(sel.X); t == nil || t.String() != "*crypto/tls.Config" {
+				continue
+			}
+			if issue := r.checkCallback(node, sel.Sel.Name, node.Rhs[i], c); issue != nil {
+				return issue, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewBrokenCertVerify detects custom TLS certificate verification callbacks which accept any certificate
+func NewBrokenCertVerify(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &brokenCertVerify{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.High,
+			Confidence: issue.High,
+			What:       "TLS certificate verification is bypassed",
+		},
+	}, []ast.Node{(*ast.CompositeLit)(nil), (*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,200 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"sort"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// cloudCredentialsPatterns are the formats of the cloud provider credentials matched by default
+var cloudCredentialsPatterns = map[string]string{
+	"AWS access key ID":          `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	"GCP API key":                `\bAIza[0-9A-Za-z\-_]{35}\b`,
+	"Azure storage account key":  `AccountKey=[0-9A-Za-z+/]{86}==`,
+	"Slack token":                `\bxox[abposr]-[0-9A-Za-z-]{10,}`,
+	"GitHub token":               `\bgh[pousr]_[0-9A-Za-z]{36}\b`,
+	"GitHub fine-grained token":  `\bgithub_pat_[0-9A-Za-z]{22}_[0-9A-Za-z]{59}\b`,
+	"Google OAuth client secret": `\bGOCSPX-[0-9A-Za-z\-_]{28}\b`,
+	"Stripe live secret key":     `\bsk_live_[0-9A-Za-z]{24,}\b`,
+}
+
+const (
+	awsAccessKeyPattern = "AWS access key ID"
+	// an AWS secret access key has no distinctive format, hence it is only reported
+	// when found in the proximity of an AWS access key ID
+	awsSecretKeyPattern   = `^[0-9A-Za-z/+=]{40}$`
+	awsSecretKeyProximity = 5
+)
+
+type namedPattern struct {
+	name   string
+	regexp *regexp.Regexp
+}
+
+type cloudCredentials struct {
+	issue.MetaData
+	patterns  []namedPattern
+	awsKey    *regexp.Regexp
+	awsSecret *regexp.Regexp
+	// deferToG101 leaves the values reported by the hardcoded credentials rule to it
+	deferToG101 bool
+}
+
+func (r *cloudCredentials) ID() string {
+	return r.MetaData.ID
+}
+
+// nearAWSAccessKey checks if a string literal holding an AWS access key ID is
+// found in the current file close to the given position
+func (r *cloudCredentials) nearAWSAccessKey(pos token.Pos, c *gosec.Context) bool {
+	if r.awsKey == nil {
+		return false
+	}
+	line := c.FileSet.Position(pos).Line
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		distance := c.FileSet.Position(lit.Pos()).Line - line
+		if distance > awsSecretKeyProximity || distance < -awsSecretKeyProximity {
+			return true
+		}
+		if val, err := gosec.GetString(lit); err == nil && r.awsKey.MatchString(val) {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+// matchesSecretPattern checks if the value matches one of the secret patterns of the hardcoded credentials rule
+func matchesSecretPattern(val string) bool {
+	for _, pattern := range secretsPatterns {
+		if pattern.regexp.MatchString(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkedAsCredential checks if the literal is the value of an assignment, a declaration or an equality
+// check, where the values matching the secret patterns are reported by the hardcoded credentials rule
+func checkedAsCredential(lit *ast.BasicLit, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found || n == nil || n.Pos() > lit.Pos() || n.End() < lit.End() {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for _, rhs := range node.Rhs {
+				found = found || rhs == lit
+			}
+		case *ast.ValueSpec:
+			for _, value := range node.Values {
+				found = found || value == lit
+			}
+		case *ast.BinaryExpr:
+			found = (node.Op == token.EQL || node.Op == token.NEQ) && (node.X == lit || node.Y == lit)
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *cloudCredentials) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, nil
+	}
+	val, err := gosec.GetString(lit)
+	if err != nil {
+		return nil, nil
+	}
+	for _, pattern := range r.patterns {
+		if pattern.regexp.MatchString(val) {
+			if r.deferToG101 && matchesSecretPattern(val) && checkedAsCredential(lit, c) {
+				return nil, nil
+			}
+			return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s", r.What, pattern.name), r.Severity, r.Confidence), nil
+		}
+	}
+	if r.awsSecret.MatchString(val) && r.nearAWSAccessKey(lit.Pos(), c) {
+		return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: AWS secret access key", r.What), r.Severity, issue.Medium), nil
+	}
+	return nil, nil
+}
+
+// NewCloudCredentials detects string literals matching the formats of the cloud
+// provider credentials, whatever the name of the variable holding them. The values
+// assigned, declared or compared which match the patterns of the hardcoded credentials
+// rule are left to that rule, unless it is excluded from the analysis.
+func NewCloudCredentials(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	patterns := make(map[string]string, len(cloudCredentialsPatterns))
+	for name, pattern := range cloudCredentialsPatterns {
+		patterns[name] = pattern
+	}
+	if configPatterns, ok := conf.RuleSettings(id).Map("patterns"); ok {
+		for name, pattern := range configPatterns {
+			if str, ok := pattern.(string); ok {
+				patterns[name] = str
+			}
+		}
+	}
+
+	rule := &cloudCredentials{
+		awsSecret:   regexp.MustCompile(awsSecretKeyPattern),
+		deferToG101: !conf.IsRuleExcluded("G101"),
+		MetaData: issue.MetaData{
+			ID:         id,
+			What:       "Potential hardcoded cloud credentials",
+			Confidence: issue.High,
+			Severity:   issue.High,
+		},
+	}
+	names := make([]string, 0, len(patterns))
+	for name := range patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// an empty pattern disables a default pattern
+		if patterns[name] == "" {
+			continue
+		}
+		re, err := regexp.Compile(patterns[name])
+		if err != nil {
+			conf.Logger().Printf("Ignoring the invalid pattern %q of %s: %s", name, id, err)
+			continue
+		}
+		rule.patterns = append(rule.patterns, namedPattern{name: name, regexp: re})
+		if name == awsAccessKeyPattern {
+			rule.awsKey = re
+		}
+	}
+	return rule, []ast.Node{(*ast.BasicLit)(nil)}
+}
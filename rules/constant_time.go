@@ -0,0 +1,153 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type nonConstantTimeCompare struct {
+	issue.MetaData
+	pattern    *regexp.Regexp
+	bytesEqual gosec.CallList
+	hmacNew    gosec.CallList
+}
+
+func (r *nonConstantTimeCompare) ID() string {
+	return r.MetaData.ID
+}
+
+// isComparable checks if the expression is a string or a byte slice which is not a
+// constant, such as the empty string, nor nil
+func isComparable(expr ast.Expr, c *gosec.Context) bool {
+	tv, ok := c.Info.Types[expr]
+	if !ok || tv.Value != nil || tv.IsNil() {
+		return false
+	}
+	switch t := tv.Type.Underlying().(type) {
+	case *types.Basic:
+		return t.Info()&types.IsString != 0
+	case *types.Slice:
+		elem, ok := t.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	}
+	return false
+}
+
+// isHMAC checks if the expression is a MAC computed with hmac.New, possibly encoded
+// or converted to a string
+func (r *nonConstantTimeCompare) isHMAC(expr ast.Expr, c *gosec.Context, visited map[types.Object]bool) bool {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.isHMAC(e.X, c, visited)
+	case *ast.Ident:
+		obj := c.Info.ObjectOf(e)
+		if obj == nil || visited[obj] {
+			return false
+		}
+		visited[obj] = true
+		if value := assignedValue(obj, c); value != nil {
+			return r.isHMAC(value, c, visited)
+		}
+	case *ast.CallExpr:
+		if r.hmacNew.ContainsPkgCallExpr(e, c, false) != nil {
+			return true
+		}
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+			switch sel.Sel.Name {
+			case "Sum":
+				return r.isHMAC(sel.X, c, visited)
+			case "EncodeToString":
+				return len(e.Args) == 1 && r.isHMAC(e.Args[0], c, visited)
+			}
+		}
+		// conversion such as string(mac)
+		if tv, ok := c.Info.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return r.isHMAC(e.Args[0], c, visited)
+		}
+	}
+	return false
+}
+
+// isSecret checks if the expression holds a MAC or is named after a secret
+func (r *nonConstantTimeCompare) isSecret(expr ast.Expr, c *gosec.Context) bool {
+	if !isComparable(expr, c) {
+		return false
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if r.pattern.MatchString(e.Name) {
+			return true
+		}
+	case *ast.SelectorExpr:
+		if r.pattern.MatchString(e.Sel.Name) {
+			return true
+		}
+	}
+	return r.isHMAC(expr, c, map[types.Object]bool{})
+}
+
+func (r *nonConstantTimeCompare) check(n ast.Node, x, y ast.Expr, c *gosec.Context) *issue.Issue {
+	if !isComparable(x, c) || !isComparable(y, c) {
+		return nil
+	}
+	if r.isSecret(x, c) || r.isSecret(y, c) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence)
+	}
+	return nil
+}
+
+func (r *nonConstantTimeCompare) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.BinaryExpr:
+		if node.Op != token.EQL && node.Op != token.NEQ {
+			return nil, nil
+		}
+		return r.check(n, node.X, node.Y, c), nil
+	case *ast.CallExpr:
+		if call := r.bytesEqual.ContainsPkgCallExpr(node, c, false); call != nil && len(call.Args) == 2 {
+			return r.check(n, call.Args[0], call.Args[1], c), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewNonConstantTimeCompare detects the comparisons of secrets with == or bytes.Equal, which
+// leak the position of the first difference through their timing. A value is considered a
+// secret when it is computed with hmac.New or when its name matches the configured "pattern".
+// The comparisons made with hmac.Equal or subtle.ConstantTimeCompare are not reported.
+func NewNonConstantTimeCompare(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	bytesEqual := gosec.NewCallList()
+	bytesEqual.Add("bytes", "Equal")
+	hmacNew := gosec.NewCallList()
+	hmacNew.Add("crypto/hmac", "New")
+	return &nonConstantTimeCompare{
+		pattern:    conf.RuleSettings(id).Regexp("pattern", `(?i)(hmac|^mac|mac$|signature|secret|token|digest)`),
+		bytesEqual: bytesEqual,
+		hmacNew:    hmacNew,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Secret compared in non-constant time, use hmac.Equal or subtle.ConstantTimeCompare",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil), (*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,86 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type missingContextTimeout struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *missingContextTimeout) ID() string {
+	return r.MetaData.ID
+}
+
+// rootContext returns the name of the function creating the context when the expression is
+// context.Background() or context.TODO(), either called directly or through the last value
+// assigned to a variable before its use
+func rootContext(expr ast.Expr, c *gosec.Context) (string, bool) {
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj := c.Info.ObjectOf(ident)
+		if obj == nil {
+			return "", false
+		}
+		if expr = reachingValue(obj, ident.Pos(), c); expr == nil {
+			return "", false
+		}
+	}
+	call, ok := gosec.MatchCallByPackage(expr, c, "context", "Background", "TODO")
+	if !ok {
+		return "", false
+	}
+	return "context." + call.Fun.(*ast.SelectorExpr).Sel.Name + "()", true
+}
+
+func (r *missingContextTimeout) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) == 0 {
+		return nil, nil
+	}
+	if name, ok := rootContext(call.Args[0], c); ok {
+		what := fmt.Sprintf("%s: %s is never canceled, consider deriving a context with context.WithTimeout", r.What, name)
+		return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewMissingContextTimeout detects outbound HTTP requests and database queries made with a
+// context which has neither a deadline nor a cancellation, so they can hang indefinitely.
+func NewMissingContextTimeout(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("net/http", "NewRequestWithContext")
+	for _, receiver := range []string{"*database/sql.DB", "*database/sql.Conn", "*database/sql.Tx"} {
+		calls.AddAll(receiver, "QueryContext", "QueryRowContext", "ExecContext", "PrepareContext")
+	}
+	calls.AddAll("*database/sql.DB", "BeginTx", "PingContext", "Conn")
+	calls.AddAll("*database/sql.Stmt", "QueryContext", "QueryRowContext", "ExecContext")
+
+	return &missingContextTimeout{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Low,
+			What:       "Outbound call without a context timeout",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,217 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/cwe"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type insecureCookie struct {
+	issue.MetaData
+	requireSameSite bool
+}
+
+func (r *insecureCookie) ID() string {
+	return r.MetaData.ID
+}
+
+// cookieAttributeSet checks if a cookie attribute is assigned to a value which is not known to disable it
+func cookieAttributeSet(value ast.Expr, c *gosec.Context) bool {
+	tv, ok := c.Info.Types[value]
+	if !ok || tv.Value == nil {
+		return true
+	}
+	switch tv.Value.Kind() {
+	case constant.Bool:
+		return constant.BoolVal(tv.Value)
+	case constant.Int:
+		// http.SameSiteDefaultMode does not emit the attribute
+		v, exact := constant.Int64Val(tv.Value)
+		return !exact || v != 1
+	}
+	return true
+}
+
+// assignedVariable returns the variable which the composite literal is assigned to
+func assignedVariable(lit *ast.CompositeLit, c *gosec.Context) types.Object {
+	var obj types.Object
+	isLit := func(expr ast.Expr) bool {
+		if unary, ok := expr.(*ast.UnaryExpr); ok {
+			expr = unary.X
+		}
+		return expr == lit
+	}
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if obj != nil {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for i, rhs := range node.Rhs {
+				if i < len(node.Lhs) && isLit(rhs) {
+					if ident, ok := node.Lhs[i].(*ast.Ident); ok {
+						obj = c.Info.ObjectOf(ident)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range node.Values {
+				if i < len(node.Names) && isLit(value) {
+					obj = c.Info.ObjectOf(node.Names[i])
+				}
+			}
+		}
+		return true
+	})
+	return obj
+}
+
+// cookieFields collects the values of the fields configured in the cookie literal and
+// the values assigned later on the fields of the variable holding the cookie.
+func cookieFields(lit *ast.CompositeLit, c *gosec.Context) map[string]ast.Expr {
+	fields := map[string]ast.Expr{}
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				fields[key.Name] = kv.Value
+			}
+		}
+	}
+	obj := assignedVariable(lit, c)
+	if obj == nil {
+		return fields
+	}
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok || i >= len(assign.Rhs) {
+				continue
+			}
+			if ident, ok := sel.X.(*ast.Ident); ok && c.Info.ObjectOf(ident) == obj {
+				fields[sel.Sel.Name] = assign.Rhs[i]
+			}
+		}
+		return true
+	})
+	return fields
+}
+
+// cookieAttributes collects the attributes configured in the cookie literal and the
+// attributes assigned later on the variable holding the cookie.
+func cookieAttributes(lit *ast.CompositeLit, c *gosec.Context) map[string]bool {
+	attributes := map[string]bool{}
+	for name, value := range cookieFields(lit, c) {
+		attributes[name] = cookieAttributeSet(value, c)
+	}
+	return attributes
+}
+
+// sameSiteNoneMode is the value of http.SameSiteNoneMode
+const sameSiteNoneMode = 4
+
+// isSameSiteNone checks if the SameSite attribute is set to http.SameSiteNoneMode
+func isSameSiteNone(value ast.Expr, c *gosec.Context) bool {
+	tv, ok := c.Info.Types[value]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return false
+	}
+	v, exact := constant.Int64Val(tv.Value)
+	return exact && v == sameSiteNoneMode
+}
+
+// sessionOptionsIssue returns the problem of the SameSite attribute configured in the
+// gorilla/sessions options, which is required regardless of the rule configuration
+func sessionOptionsIssue(lit *ast.CompositeLit, c *gosec.Context) string {
+	fields := cookieFields(lit, c)
+	sameSite, ok := fields["SameSite"]
+	if !ok || !cookieAttributeSet(sameSite, c) {
+		return "SameSite not set"
+	}
+	if isSameSiteNone(sameSite, c) {
+		if secure, ok := fields["Secure"]; !ok || !cookieAttributeSet(secure, c) {
+			return "SameSite set to None without Secure"
+		}
+	}
+	return ""
+}
+
+// withSameSiteCwe maps the issue to the SameSite weakness instead of the one of the rule
+func withSameSiteCwe(i *issue.Issue) *issue.Issue {
+	i.Cwe = cwe.Get("1275")
+	return i
+}
+
+func (r *insecureCookie) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if lit := gosec.MatchCompLit(n, c, "github.com/gorilla/sessions.Options"); lit != nil {
+		if problem := sessionOptionsIssue(lit, c); problem != "" {
+			what := fmt.Sprintf("%s: %s", r.What, problem)
+			return withSameSiteCwe(c.NewIssue(n, r.ID(), what, r.Severity, issue.Medium)), nil
+		}
+		return nil, nil
+	}
+	lit := gosec.MatchCompLit(n, c, "net/http.Cookie")
+	if lit == nil {
+		return nil, nil
+	}
+	attributes := cookieAttributes(lit, c)
+	required := []string{"Secure", "HttpOnly"}
+	if r.requireSameSite {
+		required = append(required, "SameSite")
+	}
+	var missing []string
+	for _, name := range required {
+		if !attributes[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		what := fmt.Sprintf("%s: %s not set", r.What, strings.Join(missing, ", "))
+		i := c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence)
+		if len(missing) == 1 && missing[0] == "SameSite" {
+			return withSameSiteCwe(i), nil
+		}
+		return i, nil
+	}
+	return nil, nil
+}
+
+// NewInsecureCookie detects http.Cookie values which do not set the Secure and HttpOnly attributes.
+// The SameSite attribute can be required as well through the rule configuration. The gorilla/sessions
+// options are reported when they do not set SameSite, or set it to None without Secure. The issues
+// which only concern the SameSite attribute are mapped to CWE-1275.
+func NewInsecureCookie(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	requireSameSite := conf.RuleSettings(id).Bool("require_samesite", false)
+	return &insecureCookie{
+		requireSameSite: requireSameSite,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "Insecure cookie",
+		},
+	}, []ast.Node{(*ast.CompositeLit)(nil)}
+}
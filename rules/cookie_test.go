@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"go/ast"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+var _ = Describe("insecure cookies", func() {
+	const source = `
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: "session", Value: "v", Secure: true, HttpOnly: true})
+	http.SetCookie(w, &http.Cookie{Name: "session", Value: "v", HttpOnly: true})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`
+	var pkg *testutils.TestPackage
+
+	BeforeEach(func() {
+		pkg = testutils.NewTestPackage()
+		pkg.AddFile("main.go", source)
+	})
+
+	AfterEach(func() {
+		pkg.Close()
+	})
+
+	It("should map the issues which only concern the SameSite attribute to CWE-1275", func() {
+		conf := gosec.Config{"G116": map[string]interface{}{"require_samesite": true}}
+		rule, _ := NewInsecureCookie("G116", conf)
+		ctx := pkg.CreateContext("main.go")
+		Expect(ctx).ShouldNot(BeNil())
+
+		issues := []*issue.Issue{}
+		ast.Inspect(ctx.Root, func(n ast.Node) bool {
+			found, err := rule.Match(n, ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			if found != nil {
+				issues = append(issues, found)
+			}
+			return true
+		})
+		Expect(issues).To(HaveLen(2))
+		Expect(issues[0].What).To(ContainSubstring("SameSite not set"))
+		Expect(issues[0].Cwe.ID).To(Equal("1275"))
+		Expect(issues[1].What).To(ContainSubstring("Secure, SameSite not set"))
+		Expect(issues[1].Cwe.ID).To(Equal("614"))
+	})
+})
@@ -0,0 +1,177 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const (
+	allowOriginHeader      = "Access-Control-Allow-Origin"
+	allowCredentialsHeader = "Access-Control-Allow-Credentials"
+)
+
+type permissiveCORS struct {
+	issue.MetaData
+	reportWildcard bool
+}
+
+func (r *permissiveCORS) ID() string {
+	return r.MetaData.ID
+}
+
+// enclosingFunc returns the body of the innermost function declaration or literal containing the node
+func enclosingFunc(n ast.Node, c *gosec.Context) *ast.BlockStmt {
+	var body *ast.BlockStmt
+	ast.Inspect(c.Root, func(node ast.Node) bool {
+		if node == nil || node.Pos() > n.Pos() || node.End() < n.End() {
+			return false
+		}
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			body = fn.Body
+		case *ast.FuncLit:
+			body = fn.Body
+		}
+		return true
+	})
+	return body
+}
+
+// headerSetCall returns the call when the node sets a header through http.Header
+func headerSetCall(n ast.Node, c *gosec.Context) (*ast.CallExpr, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Set" && sel.Sel.Name != "Add") {
+		return nil, false
+	}
+	if t := c.Info.TypeOf(sel.X); t == nil || t.String() != "net/http.Header" {
+		return nil, false
+	}
+	return call, true
+}
+
+// headerSet returns the name and the value of a header set through http.Header
+func headerSet(n ast.Node, c *gosec.Context) (string, string, bool) {
+	call, ok := headerSetCall(n, c)
+	if !ok {
+		return "", "", false
+	}
+	name, err := gosec.GetString(call.Args[0])
+	if err != nil {
+		return "", "", false
+	}
+	value, err := gosec.GetString(call.Args[1])
+	if err != nil {
+		return "", "", false
+	}
+	return name, value, true
+}
+
+// setsAllowCredentials checks if the function body enables the credentials header
+func setsAllowCredentials(body *ast.BlockStmt, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if name, value, ok := headerSet(n, c); ok && strings.EqualFold(name, allowCredentialsHeader) && value == "true" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// corsOptions returns whether the rs/cors options allow any origin and the credentials
+func corsOptions(lit *ast.CompositeLit, c *gosec.Context) (bool, bool) {
+	wildcard, credentials := false, false
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch key.Name {
+		case "AllowedOrigins":
+			if origins, ok := kv.Value.(*ast.CompositeLit); ok {
+				for _, origin := range origins.Elts {
+					if value, err := gosec.GetString(origin); err == nil && value == "*" {
+						wildcard = true
+					}
+				}
+			}
+		case "AllowCredentials":
+			if tv, ok := c.Info.Types[kv.Value]; ok && tv.Value != nil && tv.Value.Kind() == constant.Bool {
+				credentials = constant.BoolVal(tv.Value)
+			}
+		}
+	}
+	return wildcard, credentials
+}
+
+func (r *permissiveCORS) newIssue(n ast.Node, credentials bool, c *gosec.Context) *issue.Issue {
+	if credentials {
+		return c.NewIssue(n, r.ID(), "CORS policy allows any origin with credentials", issue.High, r.Confidence)
+	}
+	if r.reportWildcard {
+		return c.NewIssue(n, r.ID(), "CORS policy allows any origin", issue.Low, r.Confidence)
+	}
+	return nil
+}
+
+func (r *permissiveCORS) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CallExpr:
+		if name, value, ok := headerSet(node, c); ok && strings.EqualFold(name, allowOriginHeader) && value == "*" {
+			body := enclosingFunc(node, c)
+			return r.newIssue(node, body != nil && setsAllowCredentials(body, c), c), nil
+		}
+		if _, matched := gosec.MatchCallByPackage(node, c, "github.com/rs/cors", "AllowAll"); matched {
+			return r.newIssue(node, false, c), nil
+		}
+	case *ast.CompositeLit:
+		if lit := gosec.MatchCompLit(node, c, "github.com/rs/cors.Options"); lit != nil {
+			if wildcard, credentials := corsOptions(lit, c); wildcard {
+				return r.newIssue(node, credentials, c), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewPermissiveCORS detects CORS policies which allow any origin along with credentials. The
+// policies allowing any origin without credentials are reported when "report_wildcard" is enabled
+// in the rule configuration.
+func NewPermissiveCORS(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	reportWildcard := conf.RuleSettings(id).Bool("report_wildcard", false)
+	return &permissiveCORS{
+		reportWildcard: reportWildcard,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.High,
+			Confidence: issue.High,
+			What:       "Permissive CORS policy",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil)}
+}
@@ -0,0 +1,113 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type cryptoErrorUnchecked struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *cryptoErrorUnchecked) ID() string {
+	return r.MetaData.ID
+}
+
+// cryptoCall returns the call and the number of its results when it is one of the cryptographic
+// operations whose last result is an error
+func (r *cryptoErrorUnchecked) cryptoCall(expr ast.Expr, c *gosec.Context) (*ast.CallExpr, int) {
+	call := r.calls.ContainsPkgCallExpr(expr, c, false)
+	if call == nil {
+		return nil, 0
+	}
+	var results []types.Type
+	switch t := c.Info.TypeOf(call).(type) {
+	case *types.Tuple:
+		for i := 0; i < t.Len(); i++ {
+			results = append(results, t.At(i).Type())
+		}
+	case nil:
+		return nil, 0
+	default:
+		results = []types.Type{t}
+	}
+	if !types.Identical(results[len(results)-1], types.Universe.Lookup("error").Type()) {
+		return nil, 0
+	}
+	return call, len(results)
+}
+
+func (r *cryptoErrorUnchecked) newIssue(n ast.Node, call *ast.CallExpr, c *gosec.Context) *issue.Issue {
+	what := r.What
+	if pkg, name, err := gosec.GetCallInfo(call, c); err == nil {
+		what = fmt.Sprintf("%s: %s.%s", r.What, pkg, name)
+	}
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence)
+}
+
+func (r *cryptoErrorUnchecked) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.ExprStmt:
+		if call, _ := r.cryptoCall(node.X, c); call != nil {
+			return r.newIssue(n, call, c), nil
+		}
+	case *ast.AssignStmt:
+		if len(node.Rhs) != 1 {
+			return nil, nil
+		}
+		call, results := r.cryptoCall(node.Rhs[0], c)
+		if call == nil || len(node.Lhs) != results {
+			return nil, nil
+		}
+		if ident, ok := node.Lhs[results-1].(*ast.Ident); ok && ident.Name == "_" {
+			return r.newIssue(n, call, c), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewCryptoErrorUnchecked detects the discarded errors of the cryptographic operations, which
+// can leave a cipher, a key or a signature unusable without notice or accept a forged message.
+// It covers a curated list of functions, so it can be enabled when the G104 rule is excluded.
+func NewCryptoErrorUnchecked(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("crypto/aes", "NewCipher")
+	calls.AddAll("crypto/des", "NewCipher", "NewTripleDESCipher")
+	calls.AddAll("crypto/cipher", "NewGCM", "NewGCMWithNonceSize", "NewGCMWithTagSize")
+	calls.Add("crypto/cipher.AEAD", "Open")
+	calls.AddAll("crypto/rsa", "GenerateKey", "EncryptOAEP", "DecryptOAEP", "EncryptPKCS1v15", "DecryptPKCS1v15",
+		"SignPKCS1v15", "SignPSS", "VerifyPKCS1v15", "VerifyPSS")
+	calls.AddAll("crypto/ecdsa", "GenerateKey", "Sign", "SignASN1")
+	calls.Add("crypto/ed25519", "GenerateKey")
+	calls.AddAll("crypto/x509", "ParseCertificate", "ParsePKCS1PrivateKey", "ParsePKCS8PrivateKey",
+		"ParseECPrivateKey", "ParsePKIXPublicKey", "CreateCertificate", "MarshalPKCS8PrivateKey")
+	calls.AddAll("crypto/tls", "LoadX509KeyPair", "X509KeyPair")
+	return &cryptoErrorUnchecked{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Error of a cryptographic operation is not checked",
+		},
+	}, []ast.Node{(*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,84 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/cwe"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type customCall struct {
+	issue.MetaData
+	calls gosec.CallList
+	cwe   *cwe.Weakness
+}
+
+func (r *customCall) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *customCall) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if node := r.calls.ContainsPkgCallExpr(n, c, false); node != nil {
+		i := c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence)
+		if r.cwe != nil {
+			i.Cwe = r.cwe
+		}
+		return i, nil
+	}
+	return nil, nil
+}
+
+// NewCustomRule creates the definition of a rule which reports the calls configured
+// in a custom rule
+func NewCustomRule(rule gosec.CustomRule) (RuleDefinition, error) {
+	var weakness *cwe.Weakness
+	if rule.CWE != "" {
+		if weakness = cwe.Get(rule.CWE); weakness == nil {
+			return RuleDefinition{}, fmt.Errorf("custom rule %s: unknown CWE %s", rule.ID, rule.CWE)
+		}
+	}
+	idx := strings.LastIndex(rule.Call, ".")
+	if idx <= 0 || idx == len(rule.Call)-1 {
+		return RuleDefinition{}, fmt.Errorf("custom rule %s: call %q is not a package path followed by a function name", rule.ID, rule.Call)
+	}
+	selector, name := rule.Call[:idx], rule.Call[idx+1:]
+	return RuleDefinition{
+		ID:          rule.ID,
+		Description: rule.Message,
+		Create: func(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+			calls := gosec.NewCallList()
+			calls.Add(selector, name)
+			// Methods with a pointer receiver are also called on addressable values
+			if strings.HasPrefix(selector, "*") {
+				calls.Add(strings.TrimPrefix(selector, "*"), name)
+			}
+			return &customCall{
+				calls: calls,
+				cwe:   weakness,
+				MetaData: issue.MetaData{
+					ID:         id,
+					Severity:   rule.Severity,
+					Confidence: rule.Confidence,
+					What:       rule.Message,
+				},
+			}, []ast.Node{(*ast.CallExpr)(nil)}
+		},
+	}, nil
+}
@@ -0,0 +1,132 @@
+package rules_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/rules"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+var _ = Describe("custom rules", func() {
+	const customRules = `
+	{
+		"custom-rules": [
+			{"id": "C001", "call": "os.Getwd", "message": "Use of the working directory", "severity": "low", "confidence": "medium"},
+			{"id": "C002", "call": "*strings.Builder.Reset", "message": "Builder reset", "severity": "high", "cwe": "676"}
+		]
+	}`
+
+	const source = `
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	dir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	var b strings.Builder
+	b.WriteString(dir)
+	fmt.Println(b.String())
+	b.Reset()
+	fmt.Println(os.Getpid())
+}
+`
+
+	newConfig := func(config string) gosec.Config {
+		cfg := gosec.NewConfig()
+		_, err := cfg.ReadFrom(strings.NewReader(config))
+		Expect(err).ShouldNot(HaveOccurred())
+		return cfg
+	}
+
+	It("should report the configured calls at the configured severity", func() {
+		config := newConfig(customRules)
+		ruleList, err := rules.GenerateWithCustomRules(config, false, rules.NewRuleFilter(false, "C001", "C002"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ruleList.Rules).Should(HaveLen(2))
+		Expect(ruleList.Rules["C001"].Description).Should(Equal("Use of the working directory"))
+
+		logger, _ := testutils.NewLogger()
+		analyzer := gosec.NewAnalyzer(config, false, false, false, 1, logger)
+		analyzer.LoadRules(ruleList.RulesInfo())
+		pkg := testutils.NewTestPackage()
+		defer pkg.Close()
+		pkg.AddFile("main.go", source)
+		Expect(pkg.Build()).To(Succeed())
+		Expect(analyzer.Process(nil, pkg.Path)).To(Succeed())
+
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(2))
+		byRule := map[string]*issue.Issue{}
+		for _, i := range issues {
+			byRule[i.RuleID] = i
+		}
+		Expect(byRule).Should(HaveKey("C001"))
+		Expect(byRule["C001"].What).Should(Equal("Use of the working directory"))
+		Expect(byRule["C001"].Severity).Should(Equal(issue.Low))
+		Expect(byRule["C001"].Confidence).Should(Equal(issue.Medium))
+		Expect(byRule["C001"].Cwe).Should(BeNil())
+		Expect(byRule).Should(HaveKey("C002"))
+		Expect(byRule["C002"].Severity).Should(Equal(issue.High))
+		Expect(byRule["C002"].Confidence).Should(Equal(issue.High))
+		Expect(byRule["C002"].Cwe.ID).Should(Equal("676"))
+	})
+
+	It("should suppress a custom rule by ID with #nosec", func() {
+		config := newConfig(customRules)
+		ruleList, err := rules.GenerateWithCustomRules(config, false, rules.NewRuleFilter(false, "C001", "C002"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		logger, _ := testutils.NewLogger()
+		analyzer := gosec.NewAnalyzer(config, false, false, false, 1, logger)
+		analyzer.LoadRules(ruleList.RulesInfo())
+		pkg := testutils.NewTestPackage()
+		defer pkg.Close()
+		suppressed := strings.NewReplacer(
+			"dir, err := os.Getwd()", "dir, err := os.Getwd() // #nosec C001",
+			"b.Reset()", "b.Reset() // #nosec C001",
+		).Replace(source)
+		pkg.AddFile("main.go", suppressed)
+		Expect(pkg.Build()).To(Succeed())
+		Expect(analyzer.Process(nil, pkg.Path)).To(Succeed())
+
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(1))
+		Expect(issues[0].RuleID).Should(Equal("C002"))
+	})
+
+	It("should include the built-in rules along with the custom rules", func() {
+		ruleList, err := rules.GenerateWithCustomRules(newConfig(customRules), false)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ruleList.Rules).Should(HaveLen(len(rules.Generate(false).Rules) + 2))
+	})
+
+	It("should exclude the custom rules with the filters", func() {
+		ruleList, err := rules.GenerateWithCustomRules(newConfig(customRules), false, rules.NewRuleFilter(true, "C001"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ruleList.Rules).ShouldNot(HaveKey("C001"))
+		Expect(ruleList.Rules).Should(HaveKey("C002"))
+	})
+
+	DescribeTable("should reject an invalid custom rule",
+		func(config string) {
+			_, err := rules.GenerateWithCustomRules(newConfig(config), false)
+			Expect(err).Should(HaveOccurred())
+		},
+		Entry("ID of a built-in rule", `{"custom-rules": [{"id": "G101", "call": "os.Getwd"}]}`),
+		Entry("duplicated ID", `{"custom-rules": [{"id": "C001", "call": "os.Getwd"}, {"id": "C001", "call": "os.Getpid"}]}`),
+		Entry("call without package", `{"custom-rules": [{"id": "C001", "call": "Getwd"}]}`),
+		Entry("unknown CWE", `{"custom-rules": [{"id": "C001", "call": "os.Getwd", "cwe": "99999"}]}`),
+	)
+})
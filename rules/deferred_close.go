@@ -0,0 +1,137 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"os"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// writeFlags are the os.OpenFile flags which open a file for writing
+const writeFlags = int64(os.O_WRONLY | os.O_RDWR | os.O_APPEND | os.O_CREATE | os.O_TRUNC)
+
+type deferredWriteClose struct {
+	issue.MetaData
+	writeOpens gosec.CallList
+	openFile   gosec.CallList
+}
+
+func (r *deferredWriteClose) ID() string {
+	return r.MetaData.ID
+}
+
+// isWriteCloser checks if the type is the io.WriteCloser interface or a type named after it
+func isWriteCloser(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "io" && named.Obj().Name() == "WriteCloser"
+}
+
+// opensForWriting checks if the call opens a file for writing
+func (r *deferredWriteClose) opensForWriting(expr ast.Expr, c *gosec.Context) bool {
+	if r.writeOpens.ContainsPkgCallExpr(expr, c, false) != nil {
+		return true
+	}
+	call := r.openFile.ContainsPkgCallExpr(expr, c, false)
+	if call == nil || len(call.Args) < 2 {
+		return false
+	}
+	tv, ok := c.Info.Types[call.Args[1]]
+	if !ok || tv.Value == nil {
+		return false
+	}
+	flags, ok := constant.Int64Val(constant.ToInt(tv.Value))
+	return ok && flags&writeFlags != 0
+}
+
+// openedForWriting checks if the file variable is assigned in the current file with
+// a call which opens the file for writing
+func (r *deferredWriteClose) openedForWriting(file types.Object, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if len(node.Rhs) != 1 || len(node.Lhs) == 0 {
+				return true
+			}
+			if ident, ok := node.Lhs[0].(*ast.Ident); ok && c.Info.ObjectOf(ident) == file {
+				found = r.opensForWriting(node.Rhs[0], c)
+			}
+		case *ast.ValueSpec:
+			if len(node.Values) == 1 && len(node.Names) > 0 && c.Info.ObjectOf(node.Names[0]) == file {
+				found = r.opensForWriting(node.Values[0], c)
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (r *deferredWriteClose) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	deferStmt, ok := n.(*ast.DeferStmt)
+	if !ok || len(deferStmt.Call.Args) != 0 {
+		return nil, nil
+	}
+	sel, ok := deferStmt.Call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Close" {
+		return nil, nil
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj := c.Info.ObjectOf(ident)
+	if obj == nil {
+		return nil, nil
+	}
+	if isWriteCloser(obj.Type()) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, issue.Medium), nil
+	}
+	if obj.Type().String() == "*os.File" && r.openedForWriting(obj, c) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewDeferredWriteClose detects the deferred Close calls on the files opened for writing,
+// which discard the error reported when the buffered data cannot be flushed. The files
+// opened only for reading are not reported.
+func NewDeferredWriteClose(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	writeOpens := gosec.NewCallList()
+	writeOpens.AddAll("os", "Create", "CreateTemp")
+	writeOpens.Add("io/ioutil", "TempFile")
+	openFile := gosec.NewCallList()
+	openFile.Add("os", "OpenFile")
+	return &deferredWriteClose{
+		writeOpens: writeOpens,
+		openFile:   openFile,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "Deferred Close discards the error of a file opened for writing",
+		},
+	}, []ast.Node{(*ast.DeferStmt)(nil)}
+}
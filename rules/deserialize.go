@@ -0,0 +1,112 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+var yamlPackages = []string{"gopkg.in/yaml.v2", "gopkg.in/yaml.v3", "sigs.k8s.io/yaml"}
+
+type unsafeDeserialize struct {
+	issue.MetaData
+	decoders  gosec.CallList
+	unmarshal gosec.CallList
+	untrusted taintSpec
+}
+
+func (r *unsafeDeserialize) ID() string {
+	return r.MetaData.ID
+}
+
+// isUnrestricted checks if the decoding target is a pointer to an interface, into
+// which any type can be decoded
+func isUnrestricted(target ast.Expr, c *gosec.Context) bool {
+	tv, ok := c.Info.Types[target]
+	if !ok {
+		return false
+	}
+	ptr, ok := tv.Type.Underlying().(*types.Pointer)
+	if !ok {
+		return false
+	}
+	_, ok = ptr.Elem().Underlying().(*types.Interface)
+	return ok
+}
+
+// decoderSource returns the reader of the decoder on which Decode is called, when the
+// decoder is created by gob.NewDecoder or by a YAML decoder
+func (r *unsafeDeserialize) decoderSource(expr ast.Expr, c *gosec.Context) ast.Expr {
+	if ident, ok := expr.(*ast.Ident); ok {
+		if obj := c.Info.ObjectOf(ident); obj != nil {
+			expr = assignedValue(obj, c)
+		}
+	}
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 1 && r.decoders.ContainsPkgCallExpr(call, c, false) != nil {
+		return call.Args[0]
+	}
+	return nil
+}
+
+func (r *unsafeDeserialize) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	if r.unmarshal.ContainsPkgCallExpr(call, c, false) != nil {
+		if len(call.Args) >= 2 && isUnrestricted(call.Args[1], c) && r.untrusted.isTainted(call.Args[0], c) {
+			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+		return nil, nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Decode" || len(call.Args) != 1 || !isUnrestricted(call.Args[0], c) {
+		return nil, nil
+	}
+	if source := r.decoderSource(sel.X, c); source != nil && r.untrusted.isTainted(source, c) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewUnsafeDeserialize detects untrusted data, read from HTTP requests or from files,
+// which is decoded with encoding/gob or YAML into an interface rather than into a
+// concrete type
+func NewUnsafeDeserialize(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	decoders := gosec.NewCallList()
+	decoders.Add("encoding/gob", "NewDecoder")
+	unmarshal := gosec.NewCallList()
+	for _, pkg := range yamlPackages {
+		decoders.Add(pkg, "NewDecoder")
+		unmarshal.Add(pkg, "Unmarshal")
+	}
+	// the data read from files is untrusted as well as the user input
+	untrusted := userInput.withSources("os", "Open", "OpenFile", "ReadFile").withSources("io/ioutil", "ReadFile")
+	return &unsafeDeserialize{
+		decoders:  decoders,
+		unmarshal: unmarshal,
+		untrusted: untrusted,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Untrusted data decoded into an interface",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -44,14 +44,7 @@ func (r *traversal) matchCallExpr(assign *ast.CallExpr, ctx *gosec.Context) (*is
 // NewDirectoryTraversal attempts to find the use of http.Dir("/")
 func NewDirectoryTraversal(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	pattern := `http\.Dir\("\/"\)|http\.Dir\('\/'\)`
-	if val, ok := conf[id]; ok {
-		conf := val.(map[string]interface{})
-		if configPattern, ok := conf["pattern"]; ok {
-			if cfgPattern, ok := configPattern.(string); ok {
-				pattern = cfgPattern
-			}
-		}
-	}
+	pattern = conf.RuleSettings(id).String("pattern", pattern)
 
 	return &traversal{
 		pattern: regexp.MustCompile(pattern),
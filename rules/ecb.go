@@ -0,0 +1,133 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type ecbModeCheck struct {
+	issue.MetaData
+	modes gosec.CallList
+}
+
+func (r *ecbModeCheck) ID() string {
+	return r.MetaData.ID
+}
+
+// isCipherBlock checks if the type provides the method set of a crypto/cipher.Block
+func isCipherBlock(t types.Type) bool {
+	methods := types.NewMethodSet(t)
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		methods = types.NewMethodSet(types.NewPointer(t))
+	}
+	for _, name := range []string{"BlockSize", "Encrypt", "Decrypt"} {
+		if methods.Lookup(nil, name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// blockCall returns the receiver of a Encrypt/Decrypt call made directly on a block cipher
+func blockCall(n ast.Node, c *gosec.Context) *ast.Ident {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Encrypt" && sel.Sel.Name != "Decrypt") {
+		return nil
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	if t := c.Info.TypeOf(ident); t == nil || !isCipherBlock(t) {
+		return nil
+	}
+	return ident
+}
+
+// wrappedInMode checks if the block cipher is passed to one of the crypto/cipher mode constructors
+func (r *ecbModeCheck) wrappedInMode(block types.Object, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call := r.modes.ContainsPkgCallExpr(n, c, false); call != nil && len(call.Args) > 0 {
+			if ident, ok := call.Args[0].(*ast.Ident); ok && c.Info.ObjectOf(ident) == block {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (r *ecbModeCheck) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	var body *ast.BlockStmt
+	switch node := n.(type) {
+	case *ast.ForStmt:
+		body = node.Body
+	case *ast.RangeStmt:
+		body = node.Body
+	default:
+		return nil, nil
+	}
+
+	var call ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call != nil {
+			return false
+		}
+		switch n.(type) {
+		// nested loops and closures are reported on their own
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			return false
+		}
+		if ident := blockCall(n, c); ident != nil {
+			if block := c.Info.ObjectOf(ident); block != nil && !r.wrappedInMode(block, c) {
+				call = n
+			}
+		}
+		return true
+	})
+	if call != nil {
+		return c.NewIssue(call, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewECBModeCheck detects block ciphers applied block by block in a loop, which is equivalent to the ECB mode
+func NewECBModeCheck(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	modes := gosec.NewCallList()
+	modes.AddAll("crypto/cipher", "NewGCM", "NewGCMWithNonceSize", "NewGCMWithTagSize",
+		"NewCBCEncrypter", "NewCBCDecrypter", "NewCTR", "NewOFB", "NewCFBEncrypter", "NewCFBDecrypter")
+	return &ecbModeCheck{
+		modes: modes,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Use of block cipher in ECB mode",
+		},
+	}, []ast.Node{(*ast.ForStmt)(nil), (*ast.RangeStmt)(nil)}
+}
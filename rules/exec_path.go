@@ -0,0 +1,66 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type relativeExecPath struct {
+	issue.MetaData
+}
+
+func (r *relativeExecPath) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *relativeExecPath) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, matched := gosec.MatchCallByPackage(n, c, "os/exec", "Command", "CommandContext")
+	if !matched {
+		return nil, nil
+	}
+	index := 0
+	if call.Fun.(*ast.SelectorExpr).Sel.Name == "CommandContext" {
+		index = 1
+	}
+	if len(call.Args) <= index {
+		return nil, nil
+	}
+	name, ok := constantString(call.Args[index], c)
+	if !ok || name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, nil
+	}
+	what := fmt.Sprintf("%s: %q is resolved with $PATH", r.What, name)
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewRelativeExecPath detects the commands run with a constant binary name without a path
+// separator, which is looked up in the directories of $PATH and can be hijacked by placing
+// another binary with the same name earlier in the search path.
+func NewRelativeExecPath(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &relativeExecPath{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "Subprocess launched with a binary name relative to $PATH",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
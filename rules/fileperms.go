@@ -17,6 +17,7 @@ package rules
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"strconv"
 
 	"github.com/securego/gosec/v2"
@@ -25,9 +26,10 @@ import (
 
 type filePermissions struct {
 	issue.MetaData
-	mode  int64
-	pkgs  []string
-	calls []string
+	mode        int64
+	pkgs        []string
+	calls       []string
+	taintedPath bool
 }
 
 // ID returns the ID of the rule.
@@ -61,14 +63,50 @@ func (r *filePermissions) Match(n ast.Node, c *gosec.Context) (*issue.Issue, err
 	for _, pkg := range r.pkgs {
 		if callexpr, matched := gosec.MatchCallByPackage(n, c, pkg, r.calls...); matched {
 			modeArg := callexpr.Args[len(callexpr.Args)-1]
-			if mode, err := gosec.GetInt(modeArg); err == nil && !modeIsSubset(mode, r.mode) || isOsPerm(modeArg) {
-				return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+			mode, resolved := fileMode(modeArg, c)
+			tainted := r.taintedPath && isTainted(callexpr.Args[0], c, nil)
+			if resolved && !modeIsSubset(mode, r.mode) || isOsPerm(modeArg) {
+				fix := c.NewFix(modeArg, "Restrict the permissions to the configured mode", fmt.Sprintf("%#o", r.mode))
+				if tainted && (mode&0o002 != 0 || isOsPerm(modeArg)) {
+					what := r.What + ": world-writable directory created on a path derived from user input"
+					i := c.NewIssue(n, r.ID(), what, r.Severity, issue.High).WithAutofix(fix)
+					return withTaintTrail(i, callexpr.Args[0], c, nil), nil
+				}
+				return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence).WithAutofix(fix), nil
+			}
+			if !resolved && isVariable(modeArg, c) {
+				if tainted {
+					return withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, issue.Medium), callexpr.Args[0], c, nil), nil
+				}
+				return c.NewIssue(n, r.ID(), r.What, r.Severity, issue.Low), nil
 			}
 		}
 	}
 	return nil, nil
 }
 
+// fileMode resolves the value of a permission argument given as a literal, as a constant
+// or as a variable assigned a single constant value.
+func fileMode(expr ast.Expr, c *gosec.Context) (int64, bool) {
+	if mode, err := gosec.GetInt(expr); err == nil {
+		return mode, true
+	}
+	if mode, _, ok := constantValue(expr, c); ok {
+		return mode, true
+	}
+	return 0, false
+}
+
+// isVariable checks if the expression is a variable
+func isVariable(expr ast.Expr, c *gosec.Context) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.Info.ObjectOf(ident).(*types.Var)
+	return ok
+}
+
 // isOsPerm check if the provide ast node contains a os.PermMode symbol
 func isOsPerm(n ast.Node) bool {
 	if node, ok := n.(*ast.SelectorExpr); ok {
@@ -115,13 +153,15 @@ func NewFilePerms(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 }
 
 // NewMkdirPerms creates a rule to detect directory creation with more permissive than
-// configured permission mask.
+// configured permission mask. The world-writable directories created on a path derived
+// from user input are reported with a high confidence.
 func NewMkdirPerms(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 	mode := getConfiguredMode(conf, id, 0o750)
 	return &filePermissions{
-		mode:  mode,
-		pkgs:  []string{"os"},
-		calls: []string{"Mkdir", "MkdirAll"},
+		mode:        mode,
+		pkgs:        []string{"os"},
+		calls:       []string{"Mkdir", "MkdirAll"},
+		taintedPath: true,
 		MetaData: issue.MetaData{
 			ID:         id,
 			Severity:   issue.Medium,
@@ -1,8 +1,14 @@
 package rules
 
 import (
+	"go/ast"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/testutils"
 )
 
 var _ = Describe("modeIsSubset", func() {
@@ -13,3 +19,120 @@ var _ = Describe("modeIsSubset", func() {
 		Expect(modeIsSubset(0o466, 0o600)).To(BeFalse())
 	})
 })
+
+var _ = Describe("file permissions held in variables", func() {
+	const source = `
+package main
+
+import (
+	"os"
+	"strconv"
+)
+
+const constPerm = 0777
+
+func main() {
+	perm := os.FileMode(0777)
+	_ = os.MkdirAll("/tmp/a", perm)
+	_ = os.MkdirAll("/tmp/b", constPerm)
+	mode, _ := strconv.ParseUint(os.Getenv("DIR_MODE"), 8, 32)
+	computed := os.FileMode(mode)
+	_ = os.MkdirAll("/tmp/c", computed)
+	reassigned := os.FileMode(0o700)
+	if os.Getenv("DEBUG") != "" {
+		reassigned = 0o777
+	}
+	_ = os.MkdirAll("/tmp/d", reassigned)
+}
+`
+	var pkg *testutils.TestPackage
+
+	BeforeEach(func() {
+		pkg = testutils.NewTestPackage()
+		pkg.AddFile("main.go", source)
+	})
+
+	AfterEach(func() {
+		pkg.Close()
+	})
+
+	It("should resolve constant permissions and report computed ones with a low confidence", func() {
+		rule, _ := NewMkdirPerms("G301", gosec.NewConfig())
+		ctx := pkg.CreateContext("main.go")
+		Expect(ctx).ShouldNot(BeNil())
+
+		issues := []*issue.Issue{}
+		ast.Inspect(ctx.Root, func(n ast.Node) bool {
+			found, err := rule.Match(n, ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			if found != nil {
+				issues = append(issues, found)
+			}
+			return true
+		})
+		Expect(issues).To(HaveLen(4))
+		Expect(issues[0].Confidence).To(Equal(issue.High))
+		Expect(issues[0].Autofix.Replacement).To(Equal("0750"))
+		Expect(issues[1].Confidence).To(Equal(issue.High))
+		Expect(issues[2].Confidence).To(Equal(issue.Low))
+		Expect(issues[2].Autofix).To(BeNil())
+		Expect(issues[3].Confidence).To(Equal(issue.Low))
+		Expect(issues[3].Autofix).To(BeNil())
+	})
+})
+
+var _ = Describe("directory permissions on tainted paths", func() {
+	const source = `
+package main
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	dir := r.URL.Query().Get("dir")
+	_ = os.MkdirAll(dir, 0o777)
+	mode, _ := strconv.ParseUint(r.URL.Query().Get("mode"), 8, 32)
+	computed := os.FileMode(mode)
+	_ = os.MkdirAll(dir, computed)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`
+	var pkg *testutils.TestPackage
+
+	BeforeEach(func() {
+		pkg = testutils.NewTestPackage()
+		pkg.AddFile("main.go", source)
+	})
+
+	AfterEach(func() {
+		pkg.Close()
+	})
+
+	It("should raise the confidence without changing the severity", func() {
+		rule, _ := NewMkdirPerms("G301", gosec.NewConfig())
+		ctx := pkg.CreateContext("main.go")
+		Expect(ctx).ShouldNot(BeNil())
+
+		issues := []*issue.Issue{}
+		ast.Inspect(ctx.Root, func(n ast.Node) bool {
+			found, err := rule.Match(n, ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			if found != nil {
+				issues = append(issues, found)
+			}
+			return true
+		})
+		Expect(issues).To(HaveLen(2))
+		Expect(issues[0].What).To(ContainSubstring("world-writable directory created on a path derived from user input"))
+		Expect(issues[0].Severity).To(Equal(issue.Medium))
+		Expect(issues[0].Confidence).To(Equal(issue.High))
+		Expect(issues[1].Severity).To(Equal(issue.Medium))
+		Expect(issues[1].Confidence).To(Equal(issue.Medium))
+	})
+})
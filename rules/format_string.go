@@ -0,0 +1,84 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// formatIndex maps the printf-like functions to the position of their format argument
+var formatIndex = map[string]int{
+	"Printf":  0,
+	"Sprintf": 0,
+	"Errorf":  0,
+	"Fatalf":  0,
+	"Panicf":  0,
+	"Fprintf": 1,
+	"Appendf": 1,
+}
+
+type formatStringInjection struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *formatStringInjection) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *formatStringInjection) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil {
+		return nil, nil
+	}
+	_, name, err := gosec.GetCallInfo(call, c)
+	if err != nil {
+		return nil, nil
+	}
+	idx, ok := formatIndex[name]
+	if !ok || idx >= len(call.Args) {
+		return nil, nil
+	}
+	format := call.Args[idx]
+	if _, ok := constantString(format, c); ok {
+		return nil, nil
+	}
+	if isTainted(format, c, nil) {
+		return withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), format, c, nil), nil
+	}
+	return nil, nil
+}
+
+// NewFormatStringInjection detects printf-like functions whose format argument is not a
+// constant but is derived from user input, which lets the user control the formatting
+// directives
+func NewFormatStringInjection(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("fmt", "Printf", "Sprintf", "Errorf", "Fprintf", "Appendf")
+	calls.AddAll("log", "Printf", "Fatalf", "Panicf")
+	calls.AddAll("*log.Logger", "Printf", "Fatalf", "Panicf")
+	return &formatStringInjection{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Format string derived from user input",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
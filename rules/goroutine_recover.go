@@ -0,0 +1,148 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// safeBuiltins lists the builtin functions which do not panic
+var safeBuiltins = map[string]bool{
+	"append":  true,
+	"cap":     true,
+	"len":     true,
+	"make":    true,
+	"new":     true,
+	"print":   true,
+	"println": true,
+	"recover": true,
+}
+
+type unrecoveredGoroutine struct {
+	issue.MetaData
+}
+
+func (r *unrecoveredGoroutine) ID() string {
+	return r.MetaData.ID
+}
+
+// canPanic checks if the function body contains a call, an index expression or a
+// type assertion, which are the usual sources of panics. The nested function literals
+// are not inspected since they might never be called.
+func canPanic(body *ast.BlockStmt, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.IndexExpr, *ast.IndexListExpr:
+			found = true
+		case *ast.TypeAssertExpr:
+			// the type switches do not panic
+			found = node.Type != nil
+		case *ast.CallExpr:
+			if tv, ok := c.Info.Types[node.Fun]; ok && tv.IsType() {
+				return true
+			}
+			if ident, ok := node.Fun.(*ast.Ident); ok {
+				if _, ok := c.Info.ObjectOf(ident).(*types.Builtin); ok && safeBuiltins[ident.Name] {
+					return true
+				}
+			}
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// callsRecover checks if the function body calls recover, outside of the nested function literals
+func callsRecover(body *ast.BlockStmt, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "recover" {
+				_, found = c.Info.ObjectOf(ident).(*types.Builtin)
+			}
+		}
+		_, isFuncLit := n.(*ast.FuncLit)
+		return !isFuncLit
+	})
+	return found
+}
+
+// recovers checks if the function body defers a function which calls recover. A deferred
+// function which cannot be resolved is assumed to recover when its name mentions it.
+func recovers(body *ast.BlockStmt, c *gosec.Context) bool {
+	for _, stmt := range body.List {
+		deferStmt, ok := stmt.(*ast.DeferStmt)
+		if !ok {
+			continue
+		}
+		if _, deferredBody := resolveFunc(deferStmt.Call.Fun, c); deferredBody != nil {
+			if callsRecover(deferredBody, c) {
+				return true
+			}
+			continue
+		}
+		var name string
+		switch fn := deferStmt.Call.Fun.(type) {
+		case *ast.Ident:
+			name = fn.Name
+		case *ast.SelectorExpr:
+			name = fn.Sel.Name
+		}
+		if strings.Contains(strings.ToLower(name), "recover") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *unrecoveredGoroutine) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	goStmt, ok := n.(*ast.GoStmt)
+	if !ok {
+		return nil, nil
+	}
+	_, body := resolveFunc(goStmt.Call.Fun, c)
+	if body == nil || !canPanic(body, c) || recovers(body, c) {
+		return nil, nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewUnrecoveredGoroutine detects goroutines which can panic without deferring a recovery,
+// in which case the panic crashes the whole process.
+func NewUnrecoveredGoroutine(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unrecoveredGoroutine{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Low,
+			What:       "Goroutine without a deferred recover can crash the process on panic",
+		},
+	}, []ast.Node{(*ast.GoStmt)(nil)}
+}
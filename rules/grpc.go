@@ -0,0 +1,80 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const grpcPkg = "google.golang.org/grpc"
+
+type grpcInsecure struct {
+	issue.MetaData
+	insecure gosec.CallList
+	strict   bool
+}
+
+func (r *grpcInsecure) ID() string {
+	return r.MetaData.ID
+}
+
+// hasCredsOption checks if the server options contain the transport credentials
+func hasCredsOption(call *ast.CallExpr, c *gosec.Context) bool {
+	for _, arg := range call.Args {
+		if _, matched := gosec.MatchCallByPackage(arg, c, grpcPkg, "Creds"); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *grpcInsecure) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if r.insecure.ContainsPkgCallExpr(n, c, false) != nil {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	if !r.strict {
+		return nil, nil
+	}
+	// the options passed as a slice cannot be inspected
+	if call, matched := gosec.MatchCallByPackage(n, c, grpcPkg, "NewServer"); matched && !call.Ellipsis.IsValid() && !hasCredsOption(call, c) {
+		return c.NewIssue(n, r.ID(), "gRPC server created without transport credentials", r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewGRPCInsecure detects gRPC connections established without transport security. The gRPC servers
+// created without transport credentials are reported when "strict" is enabled in the rule configuration.
+func NewGRPCInsecure(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	insecure := gosec.NewCallList()
+	insecure.Add(grpcPkg, "WithInsecure")
+	insecure.Add(grpcPkg+"/credentials/insecure", "NewCredentials")
+
+	strict := false
+	strict = conf.RuleSettings(id).Bool("strict", strict)
+
+	return &grpcInsecure{
+		insecure: insecure,
+		strict:   strict,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "gRPC connection without transport security",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,61 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type grpcDebugExposed struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *grpcDebugExposed) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *grpcDebugExposed) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if call := r.calls.ContainsPkgCallExpr(n, c, false); call != nil {
+		pkg, name, _ := gosec.GetCallInfo(call, c)
+		what := fmt.Sprintf("%s: %s.%s", r.What, pkg, name)
+		return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewGRPCDebugExposed detects gRPC servers registering the reflection, channelz or admin services,
+// which disclose the services, the messages and the connections of the server to any client.
+func NewGRPCDebugExposed(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add(grpcPkg+"/reflection", "Register")
+	calls.Add(grpcPkg+"/reflection", "RegisterV1")
+	calls.Add(grpcPkg+"/channelz/service", "RegisterChannelzServiceToServer")
+	calls.Add(grpcPkg+"/admin", "Register")
+
+	return &grpcDebugExposed{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "gRPC debug service registered",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
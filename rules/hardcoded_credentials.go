@@ -19,7 +19,6 @@ import (
 	"go/ast"
 	"go/token"
 	"regexp"
-	"strconv"
 
 	zxcvbn "github.com/ccojocar/zxcvbn-go"
 
@@ -183,12 +182,16 @@ var secretsPatterns = [...]secretPattern{
 	},
 }
 
+// credentialsPattern matches the names of identifiers which are likely holding credentials
+const credentialsPattern = `(?i)passwd|pass|password|pwd|secret|token|pw|apiKey|bearer|cred`
+
 type credentials struct {
 	issue.MetaData
 	pattern          *regexp.Regexp
 	entropyThreshold float64
 	perCharThreshold float64
 	truncate         int
+	minLength        int
 	ignoreEntropy    bool
 }
 
@@ -203,13 +206,26 @@ func truncate(s string, n int) string {
 	return s[:n]
 }
 
-func (r *credentials) isHighEntropyString(str string) bool {
+func (r *credentials) isHighEntropyString(str string) (bool, float64) {
 	s := truncate(str, r.truncate)
 	info := zxcvbn.PasswordStrength(s, []string{})
 	entropyPerChar := info.Entropy / float64(len(s))
 	return (info.Entropy >= r.entropyThreshold ||
 		(info.Entropy >= (r.entropyThreshold/2) &&
-			entropyPerChar >= r.perCharThreshold))
+			entropyPerChar >= r.perCharThreshold)), info.Entropy
+}
+
+// isCandidate checks if the string is long enough and, unless the entropy is ignored, if its
+// entropy is high enough to be a credential. The returned details contain the computed entropy.
+func (r *credentials) isCandidate(str string) (bool, string) {
+	if len(str) < r.minLength {
+		return false, ""
+	}
+	if r.ignoreEntropy {
+		return true, ""
+	}
+	high, entropy := r.isHighEntropyString(str)
+	return high, fmt.Sprintf(" (entropy: %.2f)", entropy)
 }
 
 func (r *credentials) isSecretPattern(str string) (bool, string) {
@@ -240,8 +256,8 @@ func (r *credentials) matchAssign(assign *ast.AssignStmt, ctx *gosec.Context) (*
 			if r.pattern.MatchString(ident.Name) {
 				for _, e := range assign.Rhs {
 					if val, err := gosec.GetString(e); err == nil {
-						if r.ignoreEntropy || (!r.ignoreEntropy && r.isHighEntropyString(val)) {
-							return ctx.NewIssue(assign, r.ID(), r.What, r.Severity, r.Confidence), nil
+						if ok, entropy := r.isCandidate(val); ok {
+							return ctx.NewIssue(assign, r.ID(), r.What+entropy, r.Severity, r.Confidence), nil
 						}
 					}
 				}
@@ -254,9 +270,9 @@ func (r *credentials) matchAssign(assign *ast.AssignStmt, ctx *gosec.Context) (*
 					continue
 				}
 
-				if r.ignoreEntropy || r.isHighEntropyString(val) {
+				if ok, entropy := r.isCandidate(val); ok {
 					if ok, patternName := r.isSecretPattern(val); ok {
-						return ctx.NewIssue(assign, r.ID(), fmt.Sprintf("%s: %s", r.What, patternName), r.Severity, r.Confidence), nil
+						return ctx.NewIssue(assign, r.ID(), fmt.Sprintf("%s: %s%s", r.What, patternName, entropy), r.Severity, r.Confidence), nil
 					}
 				}
 			}
@@ -275,8 +291,8 @@ func (r *credentials) matchValueSpec(valueSpec *ast.ValueSpec, ctx *gosec.Contex
 				index = len(valueSpec.Values) - 1
 			}
 			if val, err := gosec.GetString(valueSpec.Values[index]); err == nil {
-				if r.ignoreEntropy || (!r.ignoreEntropy && r.isHighEntropyString(val)) {
-					return ctx.NewIssue(valueSpec, r.ID(), r.What, r.Severity, r.Confidence), nil
+				if ok, entropy := r.isCandidate(val); ok {
+					return ctx.NewIssue(valueSpec, r.ID(), r.What+entropy, r.Severity, r.Confidence), nil
 				}
 			}
 		}
@@ -285,9 +301,9 @@ func (r *credentials) matchValueSpec(valueSpec *ast.ValueSpec, ctx *gosec.Contex
 	// Now that no variable names have been matched, match the actual values to find any creds
 	for _, ident := range valueSpec.Values {
 		if val, err := gosec.GetString(ident); err == nil {
-			if r.ignoreEntropy || r.isHighEntropyString(val) {
+			if ok, entropy := r.isCandidate(val); ok {
 				if ok, patternName := r.isSecretPattern(val); ok {
-					return ctx.NewIssue(valueSpec, r.ID(), fmt.Sprintf("%s: %s", r.What, patternName), r.Severity, r.Confidence), nil
+					return ctx.NewIssue(valueSpec, r.ID(), fmt.Sprintf("%s: %s%s", r.What, patternName, entropy), r.Severity, r.Confidence), nil
 				}
 			}
 		}
@@ -309,8 +325,8 @@ func (r *credentials) matchEqualityCheck(binaryExpr *ast.BinaryExpr, ctx *gosec.
 				valueNode = binaryExpr.X
 			}
 			if val, err := gosec.GetString(valueNode); err == nil {
-				if r.ignoreEntropy || (!r.ignoreEntropy && r.isHighEntropyString(val)) {
-					return ctx.NewIssue(binaryExpr, r.ID(), r.What, r.Severity, r.Confidence), nil
+				if ok, entropy := r.isCandidate(val); ok {
+					return ctx.NewIssue(binaryExpr, r.ID(), r.What+entropy, r.Severity, r.Confidence), nil
 				}
 			}
 		}
@@ -324,9 +340,9 @@ func (r *credentials) matchEqualityCheck(binaryExpr *ast.BinaryExpr, ctx *gosec.
 
 		if ok && identStrConst.Kind == token.STRING {
 			s, _ := gosec.GetString(identStrConst)
-			if r.ignoreEntropy || r.isHighEntropyString(s) {
+			if ok, entropy := r.isCandidate(s); ok {
 				if ok, patternName := r.isSecretPattern(s); ok {
-					return ctx.NewIssue(binaryExpr, r.ID(), fmt.Sprintf("%s: %s", r.What, patternName), r.Severity, r.Confidence), nil
+					return ctx.NewIssue(binaryExpr, r.ID(), fmt.Sprintf("%s: %s%s", r.What, patternName, entropy), r.Severity, r.Confidence), nil
 				}
 			}
 		}
@@ -337,46 +353,19 @@ func (r *credentials) matchEqualityCheck(binaryExpr *ast.BinaryExpr, ctx *gosec.
 // NewHardcodedCredentials attempts to find high entropy string constants being
 // assigned to variables that appear to be related to credentials.
 func NewHardcodedCredentials(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
-	pattern := `(?i)passwd|pass|password|pwd|secret|token|pw|apiKey|bearer|cred`
+	pattern := credentialsPattern
 	entropyThreshold := 80.0
 	perCharThreshold := 3.0
 	ignoreEntropy := false
 	truncateString := 16
-	if val, ok := conf[id]; ok {
-		conf := val.(map[string]interface{})
-		if configPattern, ok := conf["pattern"]; ok {
-			if cfgPattern, ok := configPattern.(string); ok {
-				pattern = cfgPattern
-			}
-		}
-
-		if configIgnoreEntropy, ok := conf["ignore_entropy"]; ok {
-			if cfgIgnoreEntropy, ok := configIgnoreEntropy.(bool); ok {
-				ignoreEntropy = cfgIgnoreEntropy
-			}
-		}
-		if configEntropyThreshold, ok := conf["entropy_threshold"]; ok {
-			if cfgEntropyThreshold, ok := configEntropyThreshold.(string); ok {
-				if parsedNum, err := strconv.ParseFloat(cfgEntropyThreshold, 64); err == nil {
-					entropyThreshold = parsedNum
-				}
-			}
-		}
-		if configCharThreshold, ok := conf["per_char_threshold"]; ok {
-			if cfgCharThreshold, ok := configCharThreshold.(string); ok {
-				if parsedNum, err := strconv.ParseFloat(cfgCharThreshold, 64); err == nil {
-					perCharThreshold = parsedNum
-				}
-			}
-		}
-		if configTruncate, ok := conf["truncate"]; ok {
-			if cfgTruncate, ok := configTruncate.(string); ok {
-				if parsedInt, err := strconv.Atoi(cfgTruncate); err == nil {
-					truncateString = parsedInt
-				}
-			}
-		}
-	}
+	minLength := 0
+	settings := conf.RuleSettings(id)
+	pattern = settings.String("pattern", pattern)
+	ignoreEntropy = settings.Bool("ignore_entropy", ignoreEntropy)
+	entropyThreshold = settings.Float("entropy_threshold", entropyThreshold)
+	perCharThreshold = settings.Float("per_char_threshold", perCharThreshold)
+	truncateString = int(settings.Int("truncate", int64(truncateString)))
+	minLength = int(settings.Int("min_length", int64(minLength)))
 
 	return &credentials{
 		pattern:          regexp.MustCompile(pattern),
@@ -384,6 +373,7 @@ func NewHardcodedCredentials(id string, conf gosec.Config) (gosec.Rule, []ast.No
 		perCharThreshold: perCharThreshold,
 		ignoreEntropy:    ignoreEntropy,
 		truncate:         truncateString,
+		minLength:        minLength,
 		MetaData: issue.MetaData{
 			ID:         id,
 			What:       "Potential hardcoded credentials",
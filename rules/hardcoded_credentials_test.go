@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"go/ast"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+var _ = Describe("Hardcoded credentials", func() {
+	const source = `
+package main
+
+import "fmt"
+
+func main() {
+	password := "f62e5bcda4fae4f82370da0c6f20697b8f8447ef"
+	token := "abcdefgh"
+	secret := "Kx9q2"
+	fmt.Println(password, token, secret)
+}
+`
+	var pkg *testutils.TestPackage
+
+	BeforeEach(func() {
+		pkg = testutils.NewTestPackage()
+		pkg.AddFile("main.go", source)
+	})
+
+	AfterEach(func() {
+		pkg.Close()
+	})
+
+	match := func(ruleConfig map[string]interface{}) []*issue.Issue {
+		conf := gosec.NewConfig()
+		conf.Set("G101", ruleConfig)
+		rule, _ := NewHardcodedCredentials("G101", conf)
+		ctx := pkg.CreateContext("main.go")
+		Expect(ctx).ShouldNot(BeNil())
+
+		issues := []*issue.Issue{}
+		ast.Inspect(ctx.Root, func(n ast.Node) bool {
+			found, err := rule.Match(n, ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			if found != nil {
+				issues = append(issues, found)
+			}
+			return true
+		})
+		return issues
+	}
+
+	It("should report more strings with a low entropy threshold", func() {
+		Expect(match(map[string]interface{}{})).To(HaveLen(1))
+		Expect(match(map[string]interface{}{"entropy_threshold": "2.0", "per_char_threshold": "0.5"})).To(HaveLen(3))
+	})
+
+	It("should report fewer strings with a high entropy threshold", func() {
+		Expect(match(map[string]interface{}{"entropy_threshold": "1000.0", "per_char_threshold": "100.0"})).To(BeEmpty())
+	})
+
+	It("should skip the strings shorter than the minimum length", func() {
+		Expect(match(map[string]interface{}{"ignore_entropy": true})).To(HaveLen(3))
+		Expect(match(map[string]interface{}{"ignore_entropy": true, "min_length": "8"})).To(HaveLen(2))
+		Expect(match(map[string]interface{}{"ignore_entropy": true, "min_length": "9"})).To(HaveLen(1))
+	})
+
+	It("should include the computed entropy in the issue details", func() {
+		issues := match(map[string]interface{}{})
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].What).To(MatchRegexp(`^Potential hardcoded credentials \(entropy: \d+\.\d{2}\)$`))
+	})
+
+	It("should not include the entropy when it is ignored", func() {
+		issues := match(map[string]interface{}{"ignore_entropy": true})
+		Expect(issues).ToNot(BeEmpty())
+		Expect(issues[0].What).To(Equal("Potential hardcoded credentials"))
+	})
+})
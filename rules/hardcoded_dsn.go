@@ -0,0 +1,94 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// dsnPatterns are the connection string formats holding a password, which is captured
+// by the first group of the pattern
+var dsnPatterns = []struct {
+	name    string
+	pattern string
+}{
+	{"PostgreSQL URL", `^postgres(?:ql)?://[^:/@\s]*:([^@\s]+)@`},
+	{"PostgreSQL connection string", `^(?:\w+=\S*\s+)+password=('[^']*'|[^\s']+)(?:\s+\w+=\S*)*$`},
+	{"PostgreSQL connection string", `^password=('[^']*'|[^\s']+)(?:\s+\w+=\S*)+$`},
+	{"MySQL URL", `^mysql://[^:/@\s]*:([^@\s]+)@`},
+	{"MySQL DSN", `^[^:/@\s]+:([^@\s]+)@(?:(?:tcp|tcp6|udp|unix)\([^)]*\))?/`},
+	{"MongoDB URL", `^mongodb(?:\+srv)?://[^:/@\s]*:([^@\s]+)@`},
+	{"Redis URL", `^rediss?://[^:/@\s]*:([^@\s]+)@`},
+}
+
+// dsnShapePattern matches the strings which look like a database connection string: a URL with
+// the scheme of a database, a MySQL DSN or a list of key=value pairs naming the host or the database
+var dsnShapePattern = regexp.MustCompile(`^(?:(?:postgres(?:ql)?|mysql|mongodb(?:\+srv)?|sqlserver|mssql)://|[^\s/@]*@(?:(?:tcp|tcp6|unix)\([^)]*\))?/|(?:\w+=\S*\s+)*(?:host|hostaddr|dbname|user)=)`)
+
+// dsnPlaceholderPattern matches the passwords which are filled in later, such as format
+// verbs, environment variables or template actions
+var dsnPlaceholderPattern = regexp.MustCompile(`^(?:'?%[-+# 0-9.]*[a-zA-Z]'?|\$\{?\w+\}?|\{\{.*\}\}|<[^>]*>|'')$`)
+
+type hardcodedDSN struct {
+	issue.MetaData
+	patterns []namedPattern
+}
+
+func (r *hardcodedDSN) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *hardcodedDSN) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, nil
+	}
+	val, err := gosec.GetString(lit)
+	if err != nil {
+		return nil, nil
+	}
+	for _, pattern := range r.patterns {
+		match := pattern.regexp.FindStringSubmatch(val)
+		if match == nil || dsnPlaceholderPattern.MatchString(match[1]) {
+			continue
+		}
+		return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s", r.What, pattern.name), r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewHardcodedDSN detects string literals holding a database connection string with an
+// embedded password, whatever the name of the variable holding them.
+func NewHardcodedDSN(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	patterns := make([]namedPattern, 0, len(dsnPatterns))
+	for _, pattern := range dsnPatterns {
+		patterns = append(patterns, namedPattern{name: pattern.name, regexp: regexp.MustCompile(pattern.pattern)})
+	}
+	return &hardcodedDSN{
+		patterns: patterns,
+		MetaData: issue.MetaData{
+			ID:         id,
+			What:       "Potential hardcoded database credentials",
+			Confidence: issue.High,
+			Severity:   issue.High,
+		},
+	}, []ast.Node{(*ast.BasicLit)(nil)}
+}
@@ -0,0 +1,186 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type hardcodedNonce struct {
+	issue.MetaData
+	calls       gosec.CallList
+	randomFills gosec.CallList
+}
+
+func (r *hardcodedNonce) ID() string {
+	return r.MetaData.ID
+}
+
+// isConstant checks if the expression is evaluated at compile time
+func isConstant(expr ast.Expr, c *gosec.Context) bool {
+	tv, ok := c.Info.Types[expr]
+	return ok && tv.Value != nil
+}
+
+// isCryptoRandReader checks if the expression is crypto/rand.Reader
+func isCryptoRandReader(expr ast.Expr, c *gosec.Context) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	obj := c.Info.ObjectOf(sel.Sel)
+	return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == "crypto/rand" && obj.Name() == "Reader"
+}
+
+// filledFromRandom checks if the variable is passed as destination buffer to crypto/rand
+// in the function of the call using it, before that call
+func (r *hardcodedNonce) filledFromRandom(obj types.Object, use ast.Node, c *gosec.Context) bool {
+	body := enclosingFunc(use, c)
+	if body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || call.End() > use.Pos() {
+			return true
+		}
+		if r.randomFills.ContainsPkgCallExpr(call, c, false) == nil {
+			if _, matched := gosec.MatchCallByPackage(call, c, "io", "ReadFull", "ReadAtLeast"); !matched {
+				return true
+			}
+			// io.ReadFull(rand.Reader, buf)
+			if len(call.Args) == 0 || !isCryptoRandReader(call.Args[0], c) {
+				return true
+			}
+		}
+		for _, arg := range call.Args {
+			if ident := bufferIdent(arg); ident != nil && c.Info.ObjectOf(ident) == obj {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// bufferIdent returns the variable behind a buffer expression such as buf or buf[:n]
+func bufferIdent(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SliceExpr:
+		return bufferIdent(e.X)
+	case *ast.ParenExpr:
+		return bufferIdent(e.X)
+	}
+	return nil
+}
+
+// isHardcoded checks if the IV/nonce expression is built only from constant values. The variables
+// are followed through their declarations and are considered safe when filled from crypto/rand before the use.
+func (r *hardcodedNonce) isHardcoded(expr ast.Expr, use ast.Node, c *gosec.Context, visited map[types.Object]bool) bool {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.isHardcoded(e.X, use, c, visited)
+	case *ast.SliceExpr:
+		return r.isHardcoded(e.X, use, c, visited)
+	case *ast.CompositeLit:
+		if len(e.Elts) == 0 {
+			return false
+		}
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if !isConstant(elt, c) {
+				return false
+			}
+		}
+		return true
+	case *ast.CallExpr:
+		// conversion of a constant string such as []byte("...")
+		if _, ok := e.Fun.(*ast.ArrayType); ok && len(e.Args) == 1 {
+			return isConstant(e.Args[0], c)
+		}
+	case *ast.Ident:
+		obj := c.Info.ObjectOf(e)
+		if obj == nil || visited[obj] || e.Obj == nil {
+			return false
+		}
+		visited[obj] = true
+		if r.filledFromRandom(obj, use, c) {
+			return false
+		}
+		switch decl := e.Obj.Decl.(type) {
+		case *ast.ValueSpec:
+			for i, name := range decl.Names {
+				if name.Name == e.Name && i < len(decl.Values) {
+					return r.isHardcoded(decl.Values[i], use, c, visited)
+				}
+			}
+		case *ast.AssignStmt:
+			if len(decl.Lhs) != len(decl.Rhs) {
+				return false
+			}
+			for i, lhs := range decl.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == e.Name {
+					return r.isHardcoded(decl.Rhs[i], use, c, visited)
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (r *hardcodedNonce) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) < 2 {
+		return nil, nil
+	}
+	// the IV or the nonce is always the second argument
+	if r.isHardcoded(call.Args[1], call, c, map[types.Object]bool{}) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewHardcodedNonce detects IVs and nonces which are hardcoded instead of generated from a random source
+func NewHardcodedNonce(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("crypto/cipher", "NewCBCEncrypter", "NewCFBEncrypter", "NewCTR", "NewOFB")
+	calls.Add("crypto/cipher.AEAD", "Seal")
+
+	randomFills := gosec.NewCallList()
+	randomFills.Add("crypto/rand", "Read")
+
+	return &hardcodedNonce{
+		calls:       calls,
+		randomFills: randomFills,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Use of hardcoded IV/nonce for encryption",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,73 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"net/http"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type responseHeaderInjection struct {
+	issue.MetaData
+	sanitizers gosec.CallList
+}
+
+func (r *responseHeaderInjection) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *responseHeaderInjection) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, ok := headerSetCall(n, c)
+	if !ok {
+		return nil, nil
+	}
+	name, constant := constantString(call.Args[0], c)
+	// the redirections are reported by the open redirect rule
+	if constant && http.CanonicalHeaderKey(name) == "Location" {
+		return nil, nil
+	}
+	value := call.Args[1]
+	if _, ok := constantString(value, c); ok || !isTainted(value, c, r.sanitizers) {
+		return nil, nil
+	}
+	what := r.What
+	if constant {
+		what = fmt.Sprintf("%s: %s", r.What, http.CanonicalHeaderKey(name))
+	}
+	return withTaintTrail(c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), value, c, r.sanitizers), nil
+}
+
+// NewResponseHeaderInjection detects user input written into the headers of an HTTP response,
+// such as a Content-Type taken from the query string, which allows the injection of headers and
+// the confusion of the MIME type. The Location header is left to the open redirect rule.
+func NewResponseHeaderInjection(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	sanitizers := gosec.NewCallList()
+	sanitizers.AddAll("net/url", "QueryEscape", "PathEscape")
+	sanitizers.AddAll("strconv", "Itoa", "FormatInt", "FormatUint", "Quote")
+	sanitizers.Add("mime", "FormatMediaType")
+	return &responseHeaderInjection{
+		sanitizers: sanitizers,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "User input written into a response header",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
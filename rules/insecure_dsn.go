@@ -0,0 +1,66 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// insecureDSNParamPattern matches the connection string parameters which disable TLS or the
+// verification of the server certificate
+var insecureDSNParamPattern = regexp.MustCompile(`(?i)(?:^|[\s?&;])(sslmode=disable|(?:tls|ssl)=(?:false|skip-verify)|encrypt=disable|tlsInsecure=true)(?:$|[\s&;])`)
+
+type insecureDSN struct {
+	issue.MetaData
+}
+
+func (r *insecureDSN) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *insecureDSN) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil, nil
+	}
+	val, err := gosec.GetString(lit)
+	if err != nil || !dsnShapePattern.MatchString(val) {
+		return nil, nil
+	}
+	if match := insecureDSNParamPattern.FindStringSubmatch(val); match != nil {
+		return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s", r.What, match[1]), r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewDBConnectionInsecure detects the database connection strings which disable TLS, such as
+// sslmode=disable for PostgreSQL or tls=false for MySQL. The connection strings are recognized
+// like in the hardcoded database credentials rule, but the issue is the cleartext transport.
+func NewDBConnectionInsecure(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &insecureDSN{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Database connection without TLS",
+		},
+	}, []ast.Node{(*ast.BasicLit)(nil)}
+}
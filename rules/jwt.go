@@ -0,0 +1,258 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+var jwtPackages = []string{
+	"github.com/golang-jwt/jwt",
+	"github.com/golang-jwt/jwt/v4",
+	"github.com/golang-jwt/jwt/v5",
+	"github.com/dgrijalva/jwt-go",
+}
+
+type insecureJWTParse struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *insecureJWTParse) ID() string {
+	return r.MetaData.ID
+}
+
+// keyFuncIndex returns the position of the keyfunc argument for the given parse function
+func keyFuncIndex(name string) int {
+	if name == "ParseWithClaims" {
+		return 2
+	}
+	return 1
+}
+
+// hasValidMethodsOption checks if the signing methods are restricted through a parser option
+func hasValidMethodsOption(call *ast.CallExpr) bool {
+	for _, arg := range call.Args {
+		if opt, ok := arg.(*ast.CallExpr); ok {
+			if sel, ok := opt.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "WithValidMethods" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolveFunc returns the signature and the body of a function literal or of a function
+// referenced by an identifier.
+func resolveFunc(expr ast.Expr, c *gosec.Context) (*ast.FuncType, *ast.BlockStmt) {
+	switch node := expr.(type) {
+	case *ast.FuncLit:
+		return node.Type, node.Body
+	case *ast.Ident:
+		obj := node.Obj
+		if obj == nil {
+			for _, f := range c.PkgFiles {
+				if obj = f.Scope.Lookup(node.Name); obj != nil {
+					break
+				}
+			}
+		}
+		if obj == nil {
+			return nil, nil
+		}
+		switch decl := obj.Decl.(type) {
+		case *ast.FuncDecl:
+			return decl.Type, decl.Body
+		case *ast.AssignStmt:
+			for i, lhs := range decl.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == node.Name && i < len(decl.Rhs) {
+					return resolveFunc(decl.Rhs[i], c)
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range decl.Names {
+				if name.Name == node.Name && i < len(decl.Values) {
+					return resolveFunc(decl.Values[i], c)
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// tokenField checks if the expression is the given field of the token
+func tokenField(expr ast.Expr, tok types.Object, field string, c *gosec.Context) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != field {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && c.Info.ObjectOf(ident) == tok
+}
+
+// comparesMethodAlg checks if the expression compares token.Method.Alg() with a constant
+func comparesMethodAlg(expr *ast.BinaryExpr, tok types.Object, c *gosec.Context) bool {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return false
+	}
+	isAlg := func(e ast.Expr) bool {
+		call, ok := e.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		return ok && sel.Sel.Name == "Alg" && tokenField(sel.X, tok, "Method", c)
+	}
+	if isAlg(expr.X) {
+		_, ok := constantString(expr.Y, c)
+		return ok
+	}
+	if isAlg(expr.Y) {
+		_, ok := constantString(expr.X, c)
+		return ok
+	}
+	return false
+}
+
+// inspectsAlgorithm checks if the keyfunc body checks the type or the name of the signing
+// method, or reads the alg header of the token received as first parameter.
+func inspectsAlgorithm(fnType *ast.FuncType, body *ast.BlockStmt, c *gosec.Context) bool {
+	if fnType.Params == nil || len(fnType.Params.List) == 0 || len(fnType.Params.List[0].Names) == 0 {
+		return false
+	}
+	tok := c.Info.ObjectOf(fnType.Params.List[0].Names[0])
+	if tok == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.TypeAssertExpr:
+			// token.Method.(*jwt.SigningMethodHMAC) or switch token.Method.(type)
+			found = tokenField(node.X, tok, "Method", c)
+		case *ast.BinaryExpr:
+			found = comparesMethodAlg(node, tok, c)
+		case *ast.IndexExpr:
+			// token.Header["alg"]
+			if tokenField(node.X, tok, "Header", c) {
+				key, ok := constantString(node.Index, c)
+				found = ok && key == "alg"
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (r *insecureJWTParse) checkKeyFunc(call *ast.CallExpr, c *gosec.Context) *issue.Issue {
+	if hasValidMethodsOption(call) {
+		return nil
+	}
+	_, name, err := gosec.GetCallInfo(call, c)
+	if err != nil {
+		return nil
+	}
+	idx := keyFuncIndex(name)
+	if idx >= len(call.Args) {
+		return nil
+	}
+	fnType, body := resolveFunc(call.Args[idx], c)
+	if fnType == nil || body == nil {
+		return nil
+	}
+	if !inspectsAlgorithm(fnType, body, c) {
+		return c.NewIssue(call, r.ID(), "JWT keyfunc returns a key without validating the token signing method", r.Severity, r.Confidence)
+	}
+	return nil
+}
+
+// checksValid looks in the current file for an access to the Valid field of the token
+func checksValid(token types.Object, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == "Valid" {
+			if ident, ok := sel.X.(*ast.Ident); ok && c.Info.ObjectOf(ident) == token {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func (r *insecureJWTParse) checkDiscardedError(assign *ast.AssignStmt, c *gosec.Context) *issue.Issue {
+	if len(assign.Rhs) != 1 || len(assign.Lhs) != 2 {
+		return nil
+	}
+	call := r.calls.ContainsPkgCallExpr(assign.Rhs[0], c, false)
+	if call == nil {
+		return nil
+	}
+	if errIdent, ok := assign.Lhs[1].(*ast.Ident); !ok || errIdent.Name != "_" {
+		return nil
+	}
+	if tokenIdent, ok := assign.Lhs[0].(*ast.Ident); ok && tokenIdent.Name != "_" {
+		if token := c.Info.ObjectOf(tokenIdent); token != nil && checksValid(token, c) {
+			return nil
+		}
+	}
+	return c.NewIssue(assign, r.ID(), "JWT parsing error is ignored and the token validity is never checked", r.Severity, issue.High)
+}
+
+func (r *insecureJWTParse) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CallExpr:
+		if call := r.calls.ContainsPkgCallExpr(node, c, false); call != nil {
+			return r.checkKeyFunc(call, c), nil
+		}
+	case *ast.AssignStmt:
+		return r.checkDiscardedError(node, c), nil
+	case *ast.ExprStmt:
+		if call := r.calls.ContainsPkgCallExpr(node.X, c, false); call != nil {
+			return c.NewIssue(node, r.ID(), "JWT parsing result is discarded", r.Severity, issue.High), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewInsecureJWTParse detects JWT parsing which does not validate the signing
+// algorithm in the keyfunc or which ignores the parsing error.
+func NewInsecureJWTParse(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	for _, pkg := range jwtPackages {
+		calls.AddAll(pkg, "Parse", "ParseWithClaims")
+		calls.AddAll("*"+pkg+".Parser", "Parse", "ParseWithClaims")
+	}
+	return &insecureJWTParse{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.High,
+			Confidence: issue.Medium,
+			What:       "Insecure JWT parsing",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil), (*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil)}
+}
@@ -0,0 +1,102 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type hardcodedJWTKey struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *hardcodedJWTKey) ID() string {
+	return r.MetaData.ID
+}
+
+// hardcodedKey checks if the key is a string or a byte slice built from literals or constants,
+// following the variables to the values they are assigned.
+func hardcodedKey(expr ast.Expr, c *gosec.Context, visited map[types.Object]bool) bool {
+	if _, ok := constantString(expr, c); ok {
+		return true
+	}
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		// conversion such as []byte("secret")
+		if _, ok := e.Fun.(*ast.ArrayType); ok && len(e.Args) == 1 {
+			return hardcodedKey(e.Args[0], c, visited)
+		}
+	case *ast.CompositeLit:
+		if _, ok := e.Type.(*ast.ArrayType); !ok || len(e.Elts) == 0 {
+			return false
+		}
+		for _, elt := range e.Elts {
+			if tv, ok := c.Info.Types[elt]; !ok || tv.Value == nil {
+				return false
+			}
+		}
+		return true
+	case *ast.Ident:
+		obj := c.Info.ObjectOf(e)
+		if _, ok := obj.(*types.Var); !ok || visited[obj] {
+			return false
+		}
+		visited[obj] = true
+		if value := assignedValue(obj, c); value != nil {
+			return hardcodedKey(value, c, visited)
+		}
+	}
+	return false
+}
+
+func (r *hardcodedJWTKey) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsCallExpr(n, c)
+	if call == nil {
+		return nil, nil
+	}
+	// SignedString(key) and Sign(signingString, key)
+	key := call.Args[len(call.Args)-1]
+	if hardcodedKey(key, c, map[types.Object]bool{}) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewHardcodedJWTKey detects JWTs signed with a key given as a string or a byte slice literal
+// or as a constant, which lets anyone reading the source code forge valid tokens.
+func NewHardcodedJWTKey(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	for _, pkg := range jwtPackages {
+		calls.Add("*"+pkg+".Token", "SignedString")
+		calls.Add(pkg+".SigningMethod", "Sign")
+		for _, method := range []string{"HMAC", "RSA", "RSAPSS", "ECDSA", "Ed25519"} {
+			calls.Add("*"+pkg+".SigningMethod"+method, "Sign")
+		}
+	}
+	return &hardcodedJWTKey{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.High,
+			Confidence: issue.High,
+			What:       "JWT signed with a hardcoded key",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,141 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// kdfParam describes a work factor argument of a key derivation function
+type kdfParam struct {
+	name    string
+	index   int
+	minimum int64
+}
+
+type weakKDFParams struct {
+	issue.MetaData
+	params map[string]map[string][]*kdfParam
+}
+
+func (r *weakKDFParams) ID() string {
+	return r.MetaData.ID
+}
+
+// constantValue returns the integer value of the expression when it is a constant. The
+// confidence is lowered when the value comes from the single assignment of a variable.
+func constantValue(expr ast.Expr, c *gosec.Context) (int64, issue.Score, bool) {
+	if tv, ok := c.Info.Types[expr]; ok && tv.Value != nil {
+		if value, ok := constant.Int64Val(constant.ToInt(tv.Value)); ok {
+			return value, issue.High, true
+		}
+		return 0, issue.Low, false
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return 0, issue.Low, false
+	}
+	obj, ok := c.Info.ObjectOf(ident).(*types.Var)
+	if !ok {
+		return 0, issue.Low, false
+	}
+	if value := singleValue(obj, c); value != nil {
+		if tv, ok := c.Info.Types[value]; ok && tv.Value != nil {
+			if value, ok := constant.Int64Val(constant.ToInt(tv.Value)); ok {
+				return value, issue.Low, true
+			}
+		}
+	}
+	return 0, issue.Low, false
+}
+
+// isBcryptDefaultCost checks if the expression refers to bcrypt.DefaultCost
+func isBcryptDefaultCost(expr ast.Expr, c *gosec.Context) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "DefaultCost" {
+		return false
+	}
+	path, ok := selectorPkg(sel, c)
+	return ok && path == "golang.org/x/crypto/bcrypt"
+}
+
+func (r *weakKDFParams) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	for pkg, funcs := range r.params {
+		for fn, params := range funcs {
+			call, matched := gosec.MatchCallByPackage(n, c, pkg, fn)
+			if !matched {
+				continue
+			}
+			for _, param := range params {
+				if param.index >= len(call.Args) {
+					continue
+				}
+				arg := call.Args[param.index]
+				if isBcryptDefaultCost(arg, c) {
+					continue
+				}
+				value, confidence, ok := constantValue(arg, c)
+				if ok && value < param.minimum {
+					what := fmt.Sprintf("%s: %s %d is below the minimum of %d", r.What, param.name, value, param.minimum)
+					return c.NewIssue(n, r.ID(), what, r.Severity, confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewWeakKDFParams detects password hashing and key derivation functions called with
+// a work factor below the recommended minimum
+func NewWeakKDFParams(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	settings := conf.RuleSettings(id)
+	bcryptCost := settings.Int("bcrypt_cost", 12)
+	scryptN := settings.Int("scrypt_n", 32768)
+	argon2Time := settings.Int("argon2_time", 1)
+	argon2Memory := settings.Int("argon2_memory", 19456)
+
+	argon2Params := []*kdfParam{
+		{name: "argon2 time", index: 2, minimum: argon2Time},
+		{name: "argon2 memory", index: 3, minimum: argon2Memory},
+	}
+	params := map[string]map[string][]*kdfParam{
+		"golang.org/x/crypto/bcrypt": {
+			"GenerateFromPassword": {{name: "bcrypt cost", index: 1, minimum: bcryptCost}},
+		},
+		"golang.org/x/crypto/scrypt": {
+			"Key": {{name: "scrypt N", index: 2, minimum: scryptN}},
+		},
+		"golang.org/x/crypto/argon2": {
+			"Key":   argon2Params,
+			"IDKey": argon2Params,
+		},
+	}
+
+	return &weakKDFParams{
+		params: params,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Weak work factor for password hashing",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,102 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// moduleVerificationPattern matches the environment settings which disable the verification
+// of the Go modules against the checksum database
+var moduleVerificationPattern = regexp.MustCompile(`\bGONOSUM(?:CHECK|DB)\b|\bGOFLAGS=(?:'[^']*|"[^"]*|\S*)-insecure\b|\bGOSUMDB=off\b`)
+
+type moduleVerificationDisabled struct {
+	issue.MetaData
+}
+
+func (r *moduleVerificationDisabled) ID() string {
+	return r.MetaData.ID
+}
+
+// insecureGoGet checks if the command arguments run go get or go install with the -insecure flag
+func insecureGoGet(args []ast.Expr, c *gosec.Context) bool {
+	if len(args) < 3 {
+		return false
+	}
+	if name, ok := constantString(args[0], c); !ok || path.Base(name) != "go" {
+		return false
+	}
+	if sub, ok := constantString(args[1], c); !ok || (sub != "get" && sub != "install") {
+		return false
+	}
+	for _, arg := range args[2:] {
+		if flag, ok := constantString(arg, c); ok && (flag == "-insecure" || flag == "--insecure") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *moduleVerificationDisabled) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.BasicLit:
+		if node.Kind != token.STRING {
+			return nil, nil
+		}
+		value, err := strconv.Unquote(node.Value)
+		if err != nil {
+			return nil, nil
+		}
+		if setting := moduleVerificationPattern.FindString(value); setting != "" {
+			what := fmt.Sprintf("%s: %s", r.What, setting)
+			return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+		}
+	case *ast.CallExpr:
+		call, matched := gosec.MatchCallByPackage(node, c, "os/exec", "Command", "CommandContext")
+		if !matched {
+			return nil, nil
+		}
+		args := call.Args
+		if call.Fun.(*ast.SelectorExpr).Sel.Name == "CommandContext" && len(args) > 0 {
+			args = args[1:]
+		}
+		if insecureGoGet(args, c) {
+			return c.NewIssue(n, r.ID(), r.What+": go get -insecure", r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewModuleVerificationDisabled detects the strings setting GONOSUMCHECK, GONOSUMDB, GOSUMDB=off
+// or GOFLAGS=-insecure, and the go get commands run with the -insecure flag, which fetch the
+// Go modules without verifying them against the checksum database.
+func NewModuleVerificationDisabled(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &moduleVerificationDisabled{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Go module verification disabled",
+		},
+	}, []ast.Node{(*ast.BasicLit)(nil), (*ast.CallExpr)(nil)}
+}
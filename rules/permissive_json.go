@@ -0,0 +1,101 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type permissiveJSONDecode struct {
+	issue.MetaData
+}
+
+func (r *permissiveJSONDecode) ID() string {
+	return r.MetaData.ID
+}
+
+// decoderVar returns the variable the decoder created by the call is assigned to
+func decoderVar(call *ast.CallExpr, body *ast.BlockStmt, c *gosec.Context) types.Object {
+	var obj types.Object
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for i, rhs := range node.Rhs {
+				if rhs == call && i < len(node.Lhs) {
+					if ident, ok := node.Lhs[i].(*ast.Ident); ok {
+						obj = c.Info.ObjectOf(ident)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range node.Values {
+				if value == call && i < len(node.Names) {
+					obj = c.Info.ObjectOf(node.Names[i])
+				}
+			}
+		}
+		return obj == nil
+	})
+	return obj
+}
+
+// disallowsUnknownFields checks if DisallowUnknownFields is called on the decoder in the function
+func disallowsUnknownFields(decoder types.Object, body *ast.BlockStmt, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "DisallowUnknownFields" {
+				if ident, ok := sel.X.(*ast.Ident); ok && c.Info.ObjectOf(ident) == decoder {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *permissiveJSONDecode) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, matched := gosec.MatchCallByPackage(n, c, "encoding/json", "NewDecoder")
+	if !matched || len(call.Args) != 1 || requestBody(call.Args[0], c) == nil {
+		return nil, nil
+	}
+	body := enclosingFunc(n, c)
+	if body == nil {
+		return nil, nil
+	}
+	// the decoders used inline, e.g. json.NewDecoder(r.Body).Decode(&v), cannot disallow the unknown fields
+	if decoder := decoderVar(call, body, c); decoder != nil && disallowsUnknownFields(decoder, body, c) {
+		return nil, nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewPermissiveJSONDecode detects the request bodies decoded with a json.Decoder which accepts
+// the unknown fields, since the fields ignored by a service may be honored by another one.
+func NewPermissiveJSONDecode(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &permissiveJSONDecode{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Request body decoded without DisallowUnknownFields",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,122 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type predictableSeed struct {
+	issue.MetaData
+	seeds   gosec.CallList
+	reseed  gosec.CallList
+	sources gosec.CallList
+	now     gosec.CallList
+}
+
+func (r *predictableSeed) ID() string {
+	return r.MetaData.ID
+}
+
+// timeBased checks if the expression is derived from the current time, either directly
+// or through the value assigned to a variable
+func (r *predictableSeed) timeBased(expr ast.Expr, c *gosec.Context, visited map[types.Object]bool) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			if r.now.ContainsPkgCallExpr(node, c, false) != nil {
+				found = true
+			}
+		case *ast.Ident:
+			if obj := c.Info.ObjectOf(node); obj != nil && !visited[obj] {
+				visited[obj] = true
+				if value := assignedValue(obj, c); value != nil {
+					found = r.timeBased(value, c, visited)
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// predictable returns the reason why the seed is predictable
+func (r *predictableSeed) predictable(seed ast.Expr, c *gosec.Context) (string, bool) {
+	if isConstant(seed, c) {
+		return "constant", true
+	}
+	if r.timeBased(seed, c, map[types.Object]bool{}) {
+		return "derived from the current time", true
+	}
+	return "", false
+}
+
+func (r *predictableSeed) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if call := r.sources.ContainsPkgCallExpr(n, c, false); call != nil {
+		for _, arg := range call.Args {
+			if reason, ok := r.predictable(arg, c); ok {
+				return c.NewIssue(n, r.ID(), "Random number generator seeded with a value which is "+reason, r.Severity, issue.High), nil
+			}
+		}
+		return nil, nil
+	}
+	if call := r.seeds.ContainsPkgCallExpr(n, c, false); call != nil && len(call.Args) == 1 {
+		if reason, ok := r.predictable(call.Args[0], c); ok {
+			return c.NewIssue(n, r.ID(), "Random number generator seeded with a value which is "+reason, r.Severity, issue.High), nil
+		}
+		if r.reseed.ContainsPkgCallExpr(call, c, false) == nil {
+			return nil, nil
+		}
+		return c.NewIssue(n, r.ID(), "Random number generator explicitly reseeded, it is seeded randomly since Go 1.20", r.Severity, issue.Low), nil
+	}
+	return nil, nil
+}
+
+// NewPredictableSeed detects math/rand generators seeded with a constant or with the current
+// time, which makes the generated values predictable. Since Go 1.20 the global generator is
+// seeded randomly, so every explicit call to the package-level rand.Seed is reported as
+// suspicious as well.
+func NewPredictableSeed(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	seeds := gosec.NewCallList()
+	seeds.Add("math/rand", "Seed")
+	seeds.Add("*math/rand.Rand", "Seed")
+	reseed := gosec.NewCallList()
+	reseed.Add("math/rand", "Seed")
+	sources := gosec.NewCallList()
+	sources.Add("math/rand", "NewSource")
+	sources.AddAll("math/rand/v2", "NewPCG")
+	now := gosec.NewCallList()
+	now.Add("time", "Now")
+	return &predictableSeed{
+		seeds:   seeds,
+		reseed:  reseed,
+		sources: sources,
+		now:     now,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Predictable seed used for the random number generator",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,119 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type predictableToken struct {
+	issue.MetaData
+	hashed  gosec.CallList
+	rand    gosec.CallList
+	pattern *regexp.Regexp
+}
+
+func (r *predictableToken) ID() string {
+	return r.MetaData.ID
+}
+
+// randCall returns the first call to math/rand found in the node
+func (r *predictableToken) randCall(n ast.Node, c *gosec.Context) *ast.CallExpr {
+	var call *ast.CallExpr
+	ast.Inspect(n, func(node ast.Node) bool {
+		if call == nil {
+			call = r.rand.ContainsPkgCallExpr(node, c, false)
+		}
+		return call == nil
+	})
+	return call
+}
+
+// tokenFunc checks if the node is inside a top level function named after a token
+func (r *predictableToken) tokenFunc(n ast.Node, c *gosec.Context) bool {
+	for _, decl := range c.Root.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= n.Pos() && n.End() <= fn.End() {
+			return r.pattern.MatchString(fn.Name.Name)
+		}
+	}
+	return false
+}
+
+func (r *predictableToken) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CallExpr:
+		if call := r.hashed.ContainsPkgCallExpr(node, c, false); call != nil {
+			_, name, _ := gosec.GetCallInfo(call, c)
+			what := fmt.Sprintf("%s: uuid.%s derives the UUID from its name", r.What, name)
+			return c.NewIssue(n, r.ID(), what, r.Severity, issue.High), nil
+		}
+	case *ast.FuncDecl:
+		if node.Body == nil || !r.pattern.MatchString(node.Name.Name) {
+			return nil, nil
+		}
+		if call := r.randCall(node.Body, c); call != nil {
+			what := fmt.Sprintf("%s: %s is generated with math/rand", r.What, node.Name.Name)
+			return c.NewIssue(call, r.ID(), what, r.Severity, r.Confidence), nil
+		}
+	case *ast.AssignStmt:
+		for _, lhs := range node.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok || !r.pattern.MatchString(ident.Name) {
+				continue
+			}
+			// the functions named after a token are reported as a whole
+			if r.tokenFunc(node, c) {
+				return nil, nil
+			}
+			for _, rhs := range node.Rhs {
+				if call := r.randCall(rhs, c); call != nil {
+					what := fmt.Sprintf("%s: %s is generated with math/rand", r.What, ident.Name)
+					return c.NewIssue(call, r.ID(), what, r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewPredictableToken detects the UUIDs derived from a name with uuid.NewMD5 or uuid.NewSHA1, and
+// the tokens generated with math/rand, either in a function or in a variable named after a token.
+// The names are matched with the "pattern" setting of the rule.
+func NewPredictableToken(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	settings := conf.RuleSettings(id)
+	hashed := gosec.NewCallList()
+	hashed.AddAll("github.com/google/uuid", "NewMD5", "NewSHA1")
+	rand := gosec.NewCallList()
+	rand.AddAll("math/rand", "Read", "Int", "Int31", "Int31n", "Int63", "Int63n", "Intn", "Uint32", "Uint64", "Perm", "Shuffle")
+	rand.AddAll("*math/rand.Rand", "Read", "Int", "Int31", "Int31n", "Int63", "Int63n", "Intn", "Uint32", "Uint64", "Perm", "Shuffle")
+	rand.AddAll("math/rand/v2", "Int", "Int32", "Int32N", "Int64", "Int64N", "IntN", "N", "Uint32", "Uint32N", "Uint64", "Uint64N", "UintN", "Perm", "Shuffle")
+
+	return &predictableToken{
+		hashed:  hashed,
+		rand:    rand,
+		pattern: settings.Regexp("pattern", `(?i)token|nonce|secret|session|otp|salt|csrf|api_?key`),
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Predictable token",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil), (*ast.FuncDecl)(nil), (*ast.AssignStmt)(nil)}
+}
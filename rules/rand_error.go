@@ -0,0 +1,86 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type ignoredRandError struct {
+	issue.MetaData
+	reads     gosec.CallList
+	readFulls gosec.CallList
+}
+
+func (r *ignoredRandError) ID() string {
+	return r.MetaData.ID
+}
+
+// randRead returns the call when it reads random bytes from crypto/rand, either with
+// rand.Read or with io.ReadFull(rand.Reader, ...)
+func (r *ignoredRandError) randRead(expr ast.Expr, c *gosec.Context) *ast.CallExpr {
+	if call := r.reads.ContainsPkgCallExpr(expr, c, false); call != nil {
+		return call
+	}
+	call := r.readFulls.ContainsPkgCallExpr(expr, c, false)
+	if call == nil || len(call.Args) == 0 {
+		return nil
+	}
+	if sel, ok := call.Args[0].(*ast.SelectorExpr); ok && sel.Sel.Name == "Reader" {
+		if path, ok := selectorPkg(sel, c); ok && path == "crypto/rand" {
+			return call
+		}
+	}
+	return nil
+}
+
+func (r *ignoredRandError) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.ExprStmt:
+		if r.randRead(node.X, c) != nil {
+			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	case *ast.AssignStmt:
+		if len(node.Rhs) != 1 || len(node.Lhs) != 2 || r.randRead(node.Rhs[0], c) == nil {
+			return nil, nil
+		}
+		if ident, ok := node.Lhs[1].(*ast.Ident); ok && ident.Name == "_" {
+			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewIgnoredRandError detects reads from crypto/rand whose error is discarded, in which
+// case the buffer can be left partially filled with predictable zeros
+func NewIgnoredRandError(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	reads := gosec.NewCallList()
+	reads.Add("crypto/rand", "Read")
+	readFulls := gosec.NewCallList()
+	readFulls.Add("io", "ReadFull")
+	return &ignoredRandError{
+		reads:     reads,
+		readFulls: readFulls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Error of the crypto/rand read is ignored, the buffer may not be filled with random bytes",
+		},
+	}, []ast.Node{(*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}
+}
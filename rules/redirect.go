@@ -0,0 +1,239 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// validatorPattern matches the names of the functions which validate a redirect
+// location, such as isAllowedRedirect or validateNext
+var validatorPattern = regexp.MustCompile(`(?i)(allow|valid|safe|trust|permit)`)
+
+type openRedirect struct {
+	issue.MetaData
+	redirects gosec.CallList
+	prefixes  gosec.CallList
+}
+
+func (r *openRedirect) ID() string {
+	return r.MetaData.ID
+}
+
+// location returns the redirect location set by a call to http.Redirect or by
+// setting the Location header of the response
+func (r *openRedirect) location(call *ast.CallExpr, c *gosec.Context) ast.Expr {
+	if r.redirects.ContainsPkgCallExpr(call, c, false) != nil && len(call.Args) == 4 {
+		return call.Args[2]
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Set" && sel.Sel.Name != "Add") || len(call.Args) != 2 {
+		return nil
+	}
+	if tv, ok := c.Info.Types[sel.X]; !ok || tv.Type.String() != "net/http.Header" {
+		return nil
+	}
+	if key, ok := constantString(call.Args[0], c); ok && strings.EqualFold(key, "Location") {
+		return call.Args[1]
+	}
+	return nil
+}
+
+// isRelativePath checks if the location starts with a constant relative path, e.g.
+// "/profile/" + name, which cannot redirect to another site
+func isRelativePath(expr ast.Expr, c *gosec.Context) bool {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return isRelativePath(e.X, c)
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return isRelativePath(e.X, c)
+		}
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" && len(e.Args) > 0 {
+			if path, ok := selectorPkg(sel, c); ok && path == "fmt" {
+				return isRelativePath(e.Args[0], c)
+			}
+		}
+	}
+	prefix, ok := constantString(expr, c)
+	return ok && strings.HasPrefix(prefix, "/") && !strings.HasPrefix(prefix, "//") && !strings.HasPrefix(prefix, "/\\")
+}
+
+// locationVars returns the variables the location is derived from
+func locationVars(expr ast.Expr, c *gosec.Context) map[types.Object]bool {
+	vars := map[types.Object]bool{}
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if v, ok := c.Info.ObjectOf(ident).(*types.Var); ok {
+				vars[v] = true
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+// refersTo checks if the expression uses one of the variables
+func refersTo(expr ast.Expr, vars map[types.Object]bool, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && vars[c.Info.ObjectOf(ident)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// nonEmptyString checks if the expression is a constant string which is not empty
+func nonEmptyString(expr ast.Expr, c *gosec.Context) bool {
+	value, ok := constantString(expr, c)
+	return ok && value != ""
+}
+
+// pinsHost checks if the prefix pins the host of an absolute URL, e.g. "https://example.com/",
+// which a location starting with it cannot escape
+func pinsHost(prefix string) bool {
+	_, rest, ok := strings.Cut(prefix, "://")
+	return ok && strings.Index(rest, "/") > 0
+}
+
+// isValidation checks if the condition validates one of the variables against an allowlist,
+// with a map lookup, a comparison with a non-empty constant, a validation function, a prefix
+// check pinning the host or a check that the location is a relative path
+func (r *openRedirect) isValidation(cond ast.Node, vars map[types.Object]bool, c *gosec.Context) bool {
+	found := false
+	relative, protocolRelative := false, false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.IndexExpr:
+			if tv, ok := c.Info.Types[node.X]; ok && refersTo(node.Index, vars, c) {
+				_, found = tv.Type.Underlying().(*types.Map)
+			}
+		case *ast.BinaryExpr:
+			if node.Op == token.EQL && (nonEmptyString(node.X, c) && refersTo(node.Y, vars, c) || nonEmptyString(node.Y, c) && refersTo(node.X, vars, c)) {
+				found = true
+			}
+		case *ast.CallExpr:
+			if len(node.Args) == 0 || !refersTo(node.Args[0], vars, c) {
+				return true
+			}
+			if r.prefixes.ContainsPkgCallExpr(node, c, false) != nil && len(node.Args) == 2 {
+				if prefix, ok := constantString(node.Args[1], c); ok {
+					switch {
+					case prefix == "/":
+						relative = true
+					case strings.HasPrefix(prefix, "//"):
+						protocolRelative = true
+					default:
+						found = strings.HasPrefix(prefix, "/") || pinsHost(prefix)
+					}
+				}
+				return true
+			}
+			switch fn := node.Fun.(type) {
+			case *ast.Ident:
+				found = validatorPattern.MatchString(fn.Name)
+			case *ast.SelectorExpr:
+				found = validatorPattern.MatchString(fn.Sel.Name)
+			}
+		}
+		return !found
+	})
+	return found || relative && protocolRelative
+}
+
+// isAllowlistCase checks if the call is in a case of the switch which lists only non-empty
+// constants, such as the allowed locations
+func isAllowlistCase(sw *ast.SwitchStmt, call *ast.CallExpr, c *gosec.Context) bool {
+	for _, stmt := range sw.Body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok || call.Pos() < clause.Pos() || call.Pos() >= clause.End() {
+			continue
+		}
+		if len(clause.List) == 0 {
+			return false
+		}
+		for _, value := range clause.List {
+			if !nonEmptyString(value, c) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// validated checks if the location is validated by a condition preceding the redirect
+func (r *openRedirect) validated(location ast.Expr, call *ast.CallExpr, c *gosec.Context) bool {
+	vars := locationVars(location, c)
+	body := enclosingFunc(call, c)
+	if len(vars) == 0 || body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found || n == nil || n.Pos() > call.Pos() {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.IfStmt:
+			found = node.Init != nil && r.isValidation(node.Init, vars, c) || r.isValidation(node.Cond, vars, c)
+		case *ast.SwitchStmt:
+			found = node.Tag != nil && refersTo(node.Tag, vars, c) && isAllowlistCase(node, call, c)
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *openRedirect) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	location := r.location(call, c)
+	if location == nil || !isTainted(location, c, nil) || isRelativePath(location, c) || r.validated(location, call, c) {
+		return nil, nil
+	}
+	return withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), location, c, nil), nil
+}
+
+// NewOpenRedirect detects HTTP redirects to a location controlled by the user which is
+// neither a relative path nor validated against an allowlist
+func NewOpenRedirect(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	redirects := gosec.NewCallList()
+	redirects.Add("net/http", "Redirect")
+	prefixes := gosec.NewCallList()
+	prefixes.Add("strings", "HasPrefix")
+	return &openRedirect{
+		redirects: redirects,
+		prefixes:  prefixes,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Potential open redirect to a location controlled by the user",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,108 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"regexp/syntax"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type redosCheck struct {
+	issue.MetaData
+	calls          gosec.CallList
+	sanitizers     gosec.CallList
+	staticPatterns bool
+}
+
+func (r *redosCheck) ID() string {
+	return r.MetaData.ID
+}
+
+// isUnboundedRepeat checks if the regular expression repeats its sub-expression without limit
+func isUnboundedRepeat(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpStar, syntax.OpPlus:
+		return true
+	case syntax.OpRepeat:
+		return re.Max == -1
+	}
+	return false
+}
+
+// hasNestedQuantifier checks if an unbounded repetition contains another unbounded
+// repetition, such as (a+)+, which leads to catastrophic backtracking in PCRE-style engines
+func hasNestedQuantifier(re *syntax.Regexp, repeated bool) bool {
+	unbounded := isUnboundedRepeat(re)
+	if unbounded && repeated {
+		return true
+	}
+	for _, sub := range re.Sub {
+		if hasNestedQuantifier(sub, repeated || unbounded) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redosCheck) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) == 0 {
+		return nil, nil
+	}
+	pattern := call.Args[0]
+	if isTainted(pattern, c, r.sanitizers) {
+		return withTaintTrail(c.NewIssue(n, r.ID(), "Regular expression compiled from user input", r.Severity, issue.Medium), pattern, c, r.sanitizers), nil
+	}
+	if !r.staticPatterns {
+		return nil, nil
+	}
+	if tv, ok := c.Info.Types[pattern]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		if re, err := syntax.Parse(constant.StringVal(tv.Value), syntax.Perl); err == nil && hasNestedQuantifier(re, false) {
+			return c.NewIssue(n, r.ID(), "Regular expression with nested quantifiers", r.Severity, issue.Low), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewReDoSCheck detects regular expressions compiled from user input. The regular expressions
+// with nested quantifiers, which are only a concern for backtracking engines, are reported when
+// "static_patterns" is enabled in the rule configuration.
+func NewReDoSCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("regexp", "Compile", "MustCompile", "CompilePOSIX", "MustCompilePOSIX", "Match", "MatchString", "MatchReader")
+	calls.AddAll("github.com/dlclark/regexp2", "Compile", "MustCompile")
+
+	sanitizers := gosec.NewCallList()
+	sanitizers.Add("regexp", "QuoteMeta")
+
+	staticPatterns := false
+	staticPatterns = conf.RuleSettings(id).Bool("static_patterns", staticPatterns)
+
+	return &redosCheck{
+		calls:          calls,
+		sanitizers:     sanitizers,
+		staticPatterns: staticPatterns,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Potential regular expression denial of service (ReDoS)",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
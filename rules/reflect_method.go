@@ -0,0 +1,71 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type reflectMethodByName struct {
+	issue.MetaData
+}
+
+func (r *reflectMethodByName) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *reflectMethodByName) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, nil
+	}
+	// the receiver is resolved from its type since the value is usually returned by a chained call
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "MethodByName" || !isReflectValue(c.Info.TypeOf(sel.X)) {
+		return nil, nil
+	}
+	name := call.Args[0]
+	if _, ok := constantString(name, c); ok || !isTainted(name, c, nil) {
+		return nil, nil
+	}
+	return withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), name, c, nil), nil
+}
+
+// isReflectValue checks if the type is reflect.Value
+func isReflectValue(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "reflect" && obj.Name() == "Value"
+}
+
+// NewReflectMethodByName detects the methods looked up with reflect.Value.MethodByName from a name
+// derived from user input, which lets the caller invoke any exported method of the value.
+func NewReflectMethodByName(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &reflectMethodByName{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Low,
+			What:       "Method selected by reflection from user input",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,111 @@
+package rules_test
+
+import (
+	"go/ast"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+	"github.com/securego/gosec/v2/rules"
+	"github.com/securego/gosec/v2/testutils"
+)
+
+// panicCall is a rule defined outside of the rules package, which reports the calls to panic
+type panicCall struct {
+	issue.MetaData
+}
+
+func (r *panicCall) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *panicCall) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if call, ok := n.(*ast.CallExpr); ok {
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "panic" {
+			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+func newPanicCall(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &panicCall{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "Call to panic",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
+
+// the rules are registered once for the whole test suite, like from an init function
+var registerErr = rules.Register(rules.RuleDefinition{ID: "X100", Description: "Calls to panic", Create: newPanicCall})
+
+var _ = Describe("registered rules", func() {
+	const source = `
+package main
+
+func main() {
+	panic("first")
+}
+
+func other() {
+	panic("second") // #nosec X100 -- only called in tests
+}
+`
+
+	It("should generate the registered rules with the built-in rules", func() {
+		Expect(registerErr).ShouldNot(HaveOccurred())
+		builders, _ := rules.Generate(false).RulesInfo()
+		Expect(builders).Should(HaveKey("X100"))
+		Expect(builders).Should(HaveKey("G101"))
+	})
+
+	It("should filter the registered rules", func() {
+		Expect(rules.Generate(false, rules.NewRuleFilter(true, "X100")).Rules).ShouldNot(HaveKey("X100"))
+		ruleList := rules.Generate(false, rules.NewRuleFilter(false, "X100"))
+		Expect(ruleList.Rules).Should(HaveLen(1))
+		Expect(ruleList.Rules).Should(HaveKey("X100"))
+	})
+
+	It("should track the suppression of the registered rules when they are excluded", func() {
+		ruleList := rules.Generate(true, rules.NewRuleFilter(true, "X100"))
+		Expect(ruleList.Rules).Should(HaveKey("X100"))
+		Expect(ruleList.RuleSuppressed).Should(HaveKeyWithValue("X100", true))
+	})
+
+	It("should reject the IDs already used by another rule", func() {
+		Expect(rules.Register(rules.RuleDefinition{ID: "G101", Create: newPanicCall})).Should(HaveOccurred())
+		Expect(rules.Register(rules.RuleDefinition{ID: "X100", Create: newPanicCall})).Should(HaveOccurred())
+		Expect(rules.Register(
+			rules.RuleDefinition{ID: "X101", Create: newPanicCall},
+			rules.RuleDefinition{ID: "X101", Create: newPanicCall},
+		)).Should(HaveOccurred())
+		Expect(rules.Generate(false).Rules).ShouldNot(HaveKey("X101"))
+	})
+
+	It("should suppress the issues of the registered rules with nosec", func() {
+		logger, _ := testutils.NewLogger()
+		analyzer := gosec.NewAnalyzer(gosec.NewConfig(), false, false, true, 1, logger)
+		analyzer.LoadRules(rules.Generate(false, rules.NewRuleFilter(false, "X100")).RulesInfo())
+		pkg := testutils.NewTestPackage()
+		defer pkg.Close()
+		pkg.AddFile("main.go", source)
+		Expect(pkg.Build()).To(Succeed())
+		Expect(analyzer.Process(nil, pkg.Path)).To(Succeed())
+
+		issues, _, _ := analyzer.Report()
+		Expect(issues).Should(HaveLen(2))
+		for _, i := range issues {
+			Expect(i.RuleID).Should(Equal("X100"))
+			if i.Line == "9" {
+				Expect(i.Suppressions).Should(Equal([]issue.SuppressionInfo{{Kind: "inSource", Justification: "only called in tests"}}))
+			} else {
+				Expect(i.Suppressions).Should(BeEmpty())
+			}
+		}
+	})
+})
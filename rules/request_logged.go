@@ -0,0 +1,76 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type requestObjectLogged struct {
+	issue.MetaData
+	sinks gosec.CallList
+}
+
+func (r *requestObjectLogged) ID() string {
+	return r.MetaData.ID
+}
+
+// isRequestType checks if the expression is an http.Request or a pointer to it
+func isRequestType(expr ast.Expr, c *gosec.Context) bool {
+	t := c.Info.TypeOf(expr)
+	return t != nil && (t.String() == "*net/http.Request" || t.String() == "net/http.Request")
+}
+
+// loggedRequest returns the description of the request object passed as argument of a log call
+func loggedRequest(arg ast.Expr, c *gosec.Context) (string, bool) {
+	if isRequestType(arg, c) {
+		return "HTTP request", true
+	}
+	if sel, ok := arg.(*ast.SelectorExpr); ok && sel.Sel.Name == "Header" && isRequestType(sel.X, c) {
+		return "HTTP request headers", true
+	}
+	return "", false
+}
+
+func (r *requestObjectLogged) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.sinks.ContainsPkgCallExpr(n, c, false)
+	if call == nil {
+		return nil, nil
+	}
+	for _, arg := range call.Args {
+		if what, ok := loggedRequest(arg, c); ok {
+			return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s", r.What, what), r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewRequestObjectLogged detects the HTTP requests or their headers written to the logs as a whole,
+// which leaks the credentials they carry such as the Authorization header or the cookies.
+func NewRequestObjectLogged(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &requestObjectLogged{
+		sinks: logSinks(),
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.High,
+			What:       "Request object written to the logs",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
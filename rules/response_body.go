@@ -0,0 +1,213 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unclosedResponseBody struct {
+	issue.MetaData
+}
+
+func (r *unclosedResponseBody) ID() string {
+	return r.MetaData.ID
+}
+
+// isBodyClose checks if the call closes the body of the response, e.g. resp.Body.Close()
+func isBodyClose(call *ast.CallExpr, resp types.Object, c *gosec.Context) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Close" {
+		return false
+	}
+	body, ok := sel.X.(*ast.SelectorExpr)
+	if !ok || body.Sel.Name != "Body" {
+		return false
+	}
+	ident, ok := body.X.(*ast.Ident)
+	return ok && c.Info.ObjectOf(ident) == resp
+}
+
+// escapes checks if the response is handed over to other code, which is then
+// responsible for closing its body
+func escapes(node ast.Node, resp types.Object, c *gosec.Context) bool {
+	isResp := func(expr ast.Expr) bool {
+		for {
+			paren, ok := expr.(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			expr = paren.X
+		}
+		ident, ok := expr.(*ast.Ident)
+		return ok && c.Info.ObjectOf(ident) == resp
+	}
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			for _, arg := range n.Args {
+				found = found || isResp(arg)
+			}
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				found = found || isResp(result)
+			}
+		case *ast.AssignStmt:
+			for _, rhs := range n.Rhs {
+				found = found || isResp(rhs)
+			}
+		case *ast.CompositeLit:
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					elt = kv.Value
+				}
+				found = found || isResp(elt)
+			}
+		case *ast.SendStmt:
+			found = found || isResp(n.Value)
+		}
+		return !found
+	})
+	return found
+}
+
+// checksError checks if the statement is a test of the error returned along with the response
+func checksError(stmt *ast.IfStmt, errObj types.Object, c *gosec.Context) bool {
+	if errObj == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(stmt.Cond, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && c.Info.ObjectOf(ident) == errObj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// returnsBeforeClose checks if the function may return between the assignment of the response
+// and the call closing its body, apart from the return when the request failed
+func returnsBeforeClose(body *ast.BlockStmt, assign *ast.AssignStmt, closePos token.Pos, errObj types.Object, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found || n == nil || n.End() <= assign.End() || n.Pos() >= closePos {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if node.Pos() > assign.End() && checksError(node, errObj, c) {
+				return false
+			}
+		case *ast.ReturnStmt:
+			found = node.Pos() > assign.End()
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *unclosedResponseBody) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 || len(assign.Lhs) == 0 {
+		return nil, nil
+	}
+	if _, ok := assign.Rhs[0].(*ast.CallExpr); !ok {
+		return nil, nil
+	}
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	if tv, ok := c.Info.Types[assign.Rhs[0]]; !ok || !returnsResponse(tv.Type) {
+		return nil, nil
+	}
+	if ident.Name == "_" {
+		return c.NewIssue(n, r.ID(), "HTTP response is discarded without closing its body", r.Severity, r.Confidence), nil
+	}
+	resp := c.Info.ObjectOf(ident)
+	body := enclosingFunc(n, c)
+	if resp == nil || body == nil || escapes(body, resp, c) {
+		return nil, nil
+	}
+	var errObj types.Object
+	if len(assign.Lhs) == 2 {
+		if errIdent, ok := assign.Lhs[1].(*ast.Ident); ok {
+			errObj = c.Info.ObjectOf(errIdent)
+		}
+	}
+
+	deferred := false
+	closePos := token.NoPos
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.DeferStmt:
+			ast.Inspect(node, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && isBodyClose(call, resp, c) {
+					deferred = true
+				}
+				return !deferred
+			})
+			return false
+		case *ast.CallExpr:
+			if isBodyClose(node, resp, c) && closePos == token.NoPos {
+				closePos = node.Pos()
+			}
+		}
+		return !deferred
+	})
+	switch {
+	case deferred:
+		return nil, nil
+	case closePos == token.NoPos:
+		return c.NewIssue(n, r.ID(), "HTTP response body is never closed", r.Severity, r.Confidence), nil
+	case returnsBeforeClose(body, assign, closePos, errObj, c):
+		return c.NewIssue(n, r.ID(), "HTTP response body is not closed when the function returns early", r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// returnsResponse checks if the first result of a call is a *http.Response
+func returnsResponse(t types.Type) bool {
+	if tuple, ok := t.(*types.Tuple); ok {
+		if tuple.Len() == 0 {
+			return false
+		}
+		t = tuple.At(0).Type()
+	}
+	return t.String() == "*net/http.Response"
+}
+
+// NewUnclosedResponseBody detects HTTP responses whose body is never closed, which leaks
+// the connections. The body is expected to be closed in the function which received the
+// response, unless the response is handed over to another function.
+func NewUnclosedResponseBody(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &unclosedResponseBody{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "HTTP response body is not closed",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil)}
+}
@@ -14,7 +14,16 @@
 
 package rules
 
-import "github.com/securego/gosec/v2"
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/analyzers"
+	"github.com/securego/gosec/v2/cwe"
+	"github.com/securego/gosec/v2/issue"
+)
 
 // RuleDefinition contains the description of a rule and a mechanism to
 // create it.
@@ -22,6 +31,9 @@ type RuleDefinition struct {
 	ID          string
 	Description string
 	Create      gosec.RuleBuilder
+	// OptIn marks the rules which only run when a filter includes them explicitly or
+	// when they are enabled in their configuration
+	OptIn bool
 }
 
 // RuleList contains a mapping of rule ID's to rule definitions and a mapping
@@ -29,18 +41,64 @@ type RuleDefinition struct {
 type RuleList struct {
 	Rules          map[string]RuleDefinition
 	RuleSuppressed map[string]bool
+	optInEnabled   map[string]bool
 }
 
 // RulesInfo returns all the create methods and the rule suppressed map for a
-// given list
+// given list. The opt-in rules which are not enabled are left out.
 func (rl RuleList) RulesInfo() (map[string]gosec.RuleBuilder, map[string]bool) {
 	builders := make(map[string]gosec.RuleBuilder)
 	for _, def := range rl.Rules {
+		if def.OptIn && !rl.optInEnabled[def.ID] {
+			continue
+		}
 		builders[def.ID] = def.Create
 	}
 	return builders, rl.RuleSuppressed
 }
 
+// RuleInfo describes a rule of the catalog
+type RuleInfo struct {
+	ID          string        `json:"id"`
+	Description string        `json:"description"`
+	Severity    issue.Score   `json:"severity"`
+	Confidence  issue.Score   `json:"confidence"`
+	Cwe         *cwe.Weakness `json:"cwe,omitempty"`
+}
+
+// Catalog describes the rules of the list and the default SSA analyzers sorted by ID. The
+// severity and the confidence are the defaults of the rules created with the given
+// configuration, while some rules may report issues with other scores.
+func (rl RuleList) Catalog(conf gosec.Config) []RuleInfo {
+	catalog := make([]RuleInfo, 0, len(rl.Rules))
+	for _, def := range rl.Rules {
+		info := RuleInfo{
+			ID:          def.ID,
+			Description: def.Description,
+			Cwe:         issue.GetCweByRule(def.ID),
+		}
+		rule, _ := def.Create(def.ID, conf)
+		if rule, ok := rule.(interface{ Metadata() issue.MetaData }); ok {
+			metadata := rule.Metadata()
+			info.Severity = metadata.Severity
+			info.Confidence = metadata.Confidence
+		}
+		catalog = append(catalog, info)
+	}
+	for _, analyzer := range analyzers.BuildDefaultAnalyzers() {
+		severity, confidence := analyzers.DefaultScores(analyzer.Name)
+		catalog = append(catalog, RuleInfo{
+			ID:          analyzer.Name,
+			Description: analyzer.Doc,
+			Severity:    severity,
+			Confidence:  confidence,
+			Cwe:         issue.GetCweByRule(analyzer.Name),
+		})
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].ID < catalog[j].ID })
+	return catalog
+}
+
 // RuleFilter can be used to include or exclude a rule depending on the return
 // value of the function
 type RuleFilter func(string) bool
@@ -60,65 +118,206 @@ func NewRuleFilter(action bool, ruleIDs ...string) RuleFilter {
 	}
 }
 
+var (
+	registeredMu    sync.Mutex
+	registeredRules []RuleDefinition
+)
+
+// Register adds rules defined outside of gosec to the rules generated by Generate and
+// GenerateWithCustomRules, so that they are filtered and suppressed like the built-in
+// rules. It is meant to be called from an init function. None of the rules is registered
+// when an ID is empty or is already used by another rule.
+func Register(defs ...RuleDefinition) error {
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+	ids := make(map[string]bool)
+	for _, rule := range append(builtinRules(), registeredRules...) {
+		ids[rule.ID] = true
+	}
+	for _, def := range defs {
+		if def.ID == "" || def.Create == nil {
+			return fmt.Errorf("registered rule %q: the ID and the builder are required", def.ID)
+		}
+		if ids[def.ID] {
+			return fmt.Errorf("registered rule %s: the ID is already used by another rule", def.ID)
+		}
+		ids[def.ID] = true
+	}
+	registeredRules = append(registeredRules, defs...)
+	return nil
+}
+
+// availableRules returns the built-in rules followed by the registered rules
+func availableRules() []RuleDefinition {
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+	return append(builtinRules(), registeredRules...)
+}
+
 // Generate the list of rules to use
 func Generate(trackSuppressions bool, filters ...RuleFilter) RuleList {
-	rules := []RuleDefinition{
+	return newRuleList(availableRules(), trackSuppressions, nil, filters...)
+}
+
+// GenerateWithCustomRules generates the list of rules to use, including the custom
+// rules defined in the configuration. The filters apply to the custom rules as well.
+// The opt-in rules can also be enabled with the "enabled" setting of their configuration.
+func GenerateWithCustomRules(conf gosec.Config, trackSuppressions bool, filters ...RuleFilter) (RuleList, error) {
+	customRules, err := conf.GetCustomRules()
+	if err != nil {
+		return RuleList{}, err
+	}
+	rules := availableRules()
+	ids := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		ids[rule.ID] = true
+	}
+	for _, customRule := range customRules {
+		if ids[customRule.ID] {
+			return RuleList{}, fmt.Errorf("custom rule %s: the ID is already used by another rule", customRule.ID)
+		}
+		rule, err := NewCustomRule(customRule)
+		if err != nil {
+			return RuleList{}, err
+		}
+		ids[rule.ID] = true
+		rules = append(rules, rule)
+	}
+	enabled := func(id string) bool {
+		return conf.RuleSettings(id).Bool("enabled", false)
+	}
+	return newRuleList(rules, trackSuppressions, enabled, filters...), nil
+}
+
+func builtinRules() []RuleDefinition {
+	return []RuleDefinition{
 		// misc
-		{"G101", "Look for hardcoded credentials", NewHardcodedCredentials},
-		{"G102", "Bind to all interfaces", NewBindsToAllNetworkInterfaces},
-		{"G103", "Audit the use of unsafe block", NewUsingUnsafe},
-		{"G104", "Audit errors not checked", NewNoErrorCheck},
-		{"G106", "Audit the use of ssh.InsecureIgnoreHostKey function", NewSSHHostKey},
-		{"G107", "Url provided to HTTP request as taint input", NewSSRFCheck},
-		{"G108", "Profiling endpoint is automatically exposed", NewPprofCheck},
-		{"G109", "Converting strconv.Atoi result to int32/int16", NewIntegerOverflowCheck},
-		{"G110", "Detect io.Copy instead of io.CopyN when decompression", NewDecompressionBombCheck},
-		{"G111", "Detect http.Dir('/') as a potential risk", NewDirectoryTraversal},
-		{"G112", "Detect ReadHeaderTimeout not configured as a potential risk", NewSlowloris},
-		{"G113", "Usage of Rat.SetString in math/big with an overflow", NewUsingOldMathBig},
-		{"G114", "Use of net/http serve function that has no support for setting timeouts", NewHTTPServeWithoutTimeouts},
+		{"G101", "Look for hardcoded credentials", NewHardcodedCredentials, false},
+		{"G102", "Bind to all interfaces", NewBindsToAllNetworkInterfaces, false},
+		{"G103", "Audit the use of unsafe block", NewUsingUnsafe, false},
+		{"G104", "Audit errors not checked", NewNoErrorCheck, false},
+		{"G106", "Audit the use of ssh.InsecureIgnoreHostKey function", NewSSHHostKey, false},
+		{"G107", "Url provided to HTTP request as taint input", NewSSRFCheck, false},
+		{"G108", "Profiling endpoint is automatically exposed", NewPprofCheck, false},
+		{"G109", "Converting strconv.Atoi result to int32/int16", NewIntegerOverflowCheck, false},
+		{"G110", "Detect io.Copy instead of io.CopyN when decompression", NewDecompressionBombCheck, false},
+		{"G111", "Detect http.Dir('/') as a potential risk", NewDirectoryTraversal, false},
+		{"G112", "Detect ReadHeaderTimeout not configured as a potential risk", NewSlowloris, false},
+		{"G113", "Usage of Rat.SetString in math/big with an overflow", NewUsingOldMathBig, false},
+		{"G114", "Use of net/http serve function that has no support for setting timeouts", NewHTTPServeWithoutTimeouts, false},
+		{"G116", "Detect cookies without the Secure, HttpOnly or SameSite attributes", NewInsecureCookie, false},
+		{"G117", "Detect http.Server without ReadTimeout or WriteTimeout configured", NewServerTimeouts, false},
+		{"G118", "Detect permissive CORS policy allowing any origin with credentials", NewPermissiveCORS, false},
+		{"G119", "Detect regular expressions compiled from user input", NewReDoSCheck, false},
+		{"G120", "Detect sensitive data written to the logs", NewSensitiveLog, false},
+		{"G121", "Look for hardcoded cloud provider credentials", NewCloudCredentials, false},
+		{"G122", "Detect HTTP redirects to a location controlled by the user", NewOpenRedirect, false},
+		{"G123", "Detect HTTP response bodies which are not closed", NewUnclosedResponseBody, false},
+		{"G124", "Detect HTTP handlers which do not set the security headers (opt-in)", NewMissingSecurityHeaders, true},
+		{"G125", "Detect HTTP request bodies read without a size limit", NewUnboundedBodyRead, false},
+		{"G127", "Detect downloaded content used without an integrity check (opt-in)", NewUnverifiedDownload, true},
+		{"G128", "Detect database connection strings with an embedded password", NewHardcodedDSN, false},
+		{"G129", "Detect type assertions without the comma-ok form on untrusted data (opt-in)", NewUnsafeTypeAssertion, true},
+		{"G130", "Detect secret environment variables passed to a subprocess or printed (opt-in)", NewSecretEnvPropagation, true},
+		{"G131", "Detect outbound HTTP requests and database queries without a context timeout (opt-in)", NewMissingContextTimeout, true},
+		{"G132", "Detect goroutines which can panic without a deferred recover (opt-in)", NewUnrecoveredGoroutine, true},
+		{"G133", "Detect gRPC servers exposing the reflection or debug services (opt-in)", NewGRPCDebugExposed, true},
+		{"G134", "Detect webhook handlers decoding the request body without verifying its HMAC signature (opt-in)", NewUnverifiedWebhook, true},
+		{"G135", "Detect subprocesses launched with a binary name relative to $PATH (opt-in)", NewRelativeExecPath, true},
+		{"G136", "Detect disabled Go module verification (opt-in)", NewModuleVerificationDisabled, true},
+		{"G137", "Method selected by reflection from user input (opt-in)", NewReflectMethodByName, true},
+		{"G138", "Request body decoded without DisallowUnknownFields (opt-in)", NewPermissiveJSONDecode, true},
+		{"G139", "Request object written to the logs (opt-in)", NewRequestObjectLogged, true},
 
 		// injection
-		{"G201", "SQL query construction using format string", NewSQLStrFormat},
-		{"G202", "SQL query construction using string concatenation", NewSQLStrConcat},
-		{"G203", "Use of unescaped data in HTML templates", NewTemplateCheck},
-		{"G204", "Audit use of command execution", NewSubproc},
+		{"G201", "SQL query construction using format string", NewSQLStrFormat, false},
+		{"G202", "SQL query construction using string concatenation", NewSQLStrConcat, false},
+		{"G203", "Use of unescaped data in HTML templates", NewTemplateCheck, false},
+		{"G204", "Audit use of command execution", NewSubproc, false},
+		{"G205", "Detect XML parsing which may process external entities", NewXXECheck, false},
+		{"G206", "Shell interpreter launched with a non-constant script", NewShellCommand, false},
+		{"G207", "Untrusted data decoded with gob or YAML into an interface", NewUnsafeDeserialize, false},
+		{"G208", "Format string derived from user input", NewFormatStringInjection, false},
+		{"G209", "User input converted to a trusted html/template type", NewTrustedTypeConversion, false},
+		{"G210", "User input written into a response header", NewResponseHeaderInjection, false},
 
 		// filesystem
-		{"G301", "Poor file permissions used when creating a directory", NewMkdirPerms},
-		{"G302", "Poor file permissions used when creation file or using chmod", NewFilePerms},
-		{"G303", "Creating tempfile using a predictable path", NewBadTempFile},
-		{"G304", "File path provided as taint input", NewReadFile},
-		{"G305", "File path traversal when extracting zip archive", NewArchive},
-		{"G306", "Poor file permissions used when writing to a file", NewWritePerms},
-		{"G307", "Poor file permissions used when creating a file with os.Create", NewOsCreatePerms},
+		{"G301", "Poor file permissions used when creating a directory", NewMkdirPerms, false},
+		{"G302", "Poor file permissions used when creation file or using chmod", NewFilePerms, false},
+		{"G303", "Creating tempfile using a predictable path", NewBadTempFile, false},
+		{"G304", "File path provided as taint input", NewReadFile, false},
+		{"G305", "File path traversal when extracting zip archive", NewArchive, false},
+		{"G306", "Poor file permissions used when writing to a file", NewWritePerms, false},
+		{"G307", "Poor file permissions used when creating a file with os.Create", NewOsCreatePerms, false},
+		{"G308", "File path traversal when extracting tar archive", NewTarArchive, false},
+		{"G309", "Temporary file created in a user-controlled or shared directory", NewPredictableTempPattern, false},
+		{"G310", "Deferred Close discards the error of a file opened for writing", NewDeferredWriteClose, false},
+		{"G311", "File written with group or world writable permissions", NewWorldWritableIoutil, false},
+		{"G312", "Secret written to a file readable by other users", NewSecretFileWorldReadable, false},
+		{"G313", "File path checked and used separately (opt-in)", NewTOCTOU, true},
 
 		// crypto
-		{"G401", "Detect the usage of MD5 or SHA1", NewUsesWeakCryptographyHash},
-		{"G402", "Look for bad TLS connection settings", NewIntermediateTLSCheck},
-		{"G403", "Ensure minimum RSA key length of 2048 bits", NewWeakKeyStrength},
-		{"G404", "Insecure random number source (rand)", NewWeakRandCheck},
-		{"G405", "Detect the usage of DES or RC4", NewUsesWeakCryptographyEncryption},
-		{"G406", "Detect the usage of deprecated MD4 or RIPEMD160", NewUsesWeakDeprecatedCryptographyHash},
+		{"G401", "Detect the usage of MD5 or SHA1", NewUsesWeakCryptographyHash, false},
+		{"G402", "Look for bad TLS connection settings", NewIntermediateTLSCheck, false},
+		{"G403", "Ensure minimum RSA key length of 2048 bits", NewWeakKeyStrength, false},
+		{"G404", "Insecure random number source (rand)", NewWeakRandCheck, false},
+		{"G405", "Detect the usage of DES or RC4", NewUsesWeakCryptographyEncryption, false},
+		{"G406", "Detect the usage of deprecated MD4 or RIPEMD160", NewUsesWeakDeprecatedCryptographyHash, false},
+		{"G407", "Detect the usage of block ciphers in ECB mode", NewECBModeCheck, false},
+		{"G408", "Detect JWT parsing without signature algorithm validation", NewInsecureJWTParse, false},
+		{"G409", "Detect the usage of hardcoded IV or nonce in encryption", NewHardcodedNonce, false},
+		{"G410", "Detect weak work factors in password hashing functions", NewWeakKDFParams, false},
+		{"G411", "Detect gRPC connections without transport security", NewGRPCInsecure, false},
+		{"G412", "Detect TLS certificate verification callbacks which accept any certificate", NewBrokenCertVerify, false},
+		{"G413", "Secret compared in non-constant time", NewNonConstantTimeCompare, false},
+		{"G414", "TLS hostname verification bypassed with an empty ServerName or a custom dialer", NewTLSHostnameVerify, false},
+		{"G415", "Predictable seed used for the math/rand random number generator", NewPredictableSeed, false},
+		{"G416", "Detect TLS configurations shared between a server and a client (opt-in)", NewSharedTLSConfig, true},
+		{"G417", "Detect database connection strings which disable TLS", NewDBConnectionInsecure, false},
+		{"G418", "Detect weak elliptic curves and Diffie-Hellman groups", NewWeakECCurve, false},
+		{"G419", "Error of a crypto/rand read is ignored", NewIgnoredRandError, false},
+		{"G420", "JWT signed with a hardcoded key", NewHardcodedJWTKey, false},
+		{"G421", "Use of a weak hash function in an HMAC", NewWeakHMACHash, false},
+		{"G422", "Error of a cryptographic operation is not checked", NewCryptoErrorUnchecked, false},
+		{"G423", "Detect predictable UUIDs and tokens (opt-in)", NewPredictableToken, true},
 
 		// blocklist
-		{"G501", "Import blocklist: crypto/md5", NewBlocklistedImportMD5},
-		{"G502", "Import blocklist: crypto/des", NewBlocklistedImportDES},
-		{"G503", "Import blocklist: crypto/rc4", NewBlocklistedImportRC4},
-		{"G504", "Import blocklist: net/http/cgi", NewBlocklistedImportCGI},
-		{"G505", "Import blocklist: crypto/sha1", NewBlocklistedImportSHA1},
-		{"G506", "Import blocklist: golang.org/x/crypto/md4", NewBlocklistedImportMD4},
-		{"G507", "Import blocklist: golang.org/x/crypto/ripemd160", NewBlocklistedImportRIPEMD160},
+		{"G501", "Import blocklist: crypto/md5", NewBlocklistedImportMD5, false},
+		{"G502", "Import blocklist: crypto/des", NewBlocklistedImportDES, false},
+		{"G503", "Import blocklist: crypto/rc4", NewBlocklistedImportRC4, false},
+		{"G504", "Import blocklist: net/http/cgi", NewBlocklistedImportCGI, false},
+		{"G505", "Import blocklist: crypto/sha1", NewBlocklistedImportSHA1, false},
+		{"G506", "Import blocklist: golang.org/x/crypto/md4", NewBlocklistedImportMD4, false},
+		{"G507", "Import blocklist: golang.org/x/crypto/ripemd160", NewBlocklistedImportRIPEMD160, false},
 
 		// memory safety
-		{"G601", "Implicit memory aliasing in RangeStmt", NewImplicitAliasing},
+		{"G601", "Implicit memory aliasing in RangeStmt", NewImplicitAliasing, false},
+		{"G603", "Unsafe pointer arithmetic or unsafe.Slice and unsafe.String with an unchecked length", NewUnsafePointerArithmetic, false},
 	}
+}
 
+// includes checks if one of the filters includes the rule explicitly, i.e. keeps the rule
+// while suppressing the rules it does not list, as the filters created by NewRuleFilter
+// with a false action do
+func includes(filters []RuleFilter, id string) bool {
+	for _, filter := range filters {
+		if !filter(id) && filter("") {
+			return true
+		}
+	}
+	return false
+}
+
+func newRuleList(rules []RuleDefinition, trackSuppressions bool, enabled func(string) bool, filters ...RuleFilter) RuleList {
 	ruleMap := make(map[string]RuleDefinition)
 	ruleSuppressedMap := make(map[string]bool)
+	optInEnabled := make(map[string]bool)
 
 RULES:
 	for _, rule := range rules {
+		if rule.OptIn {
+			optInEnabled[rule.ID] = includes(filters, rule.ID) || enabled != nil && enabled(rule.ID)
+		}
 		ruleSuppressedMap[rule.ID] = false
 		for _, filter := range filters {
 			if filter(rule.ID) {
@@ -130,5 +329,5 @@ RULES:
 		}
 		ruleMap[rule.ID] = rule
 	}
-	return RuleList{ruleMap, ruleSuppressedMap}
+	return RuleList{Rules: ruleMap, RuleSuppressed: ruleSuppressedMap, optInEnabled: optInEnabled}
 }
@@ -0,0 +1,41 @@
+package rules_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/rules"
+)
+
+var _ = Describe("opt-in rules", func() {
+	It("should list the opt-in rules without running them by default", func() {
+		ruleList := rules.Generate(false)
+		Expect(ruleList.Rules).Should(HaveKey("G124"))
+		Expect(ruleList.Rules["G124"].OptIn).Should(BeTrue())
+		builders, _ := ruleList.RulesInfo()
+		Expect(builders).ShouldNot(HaveKey("G124"))
+		Expect(builders).Should(HaveKey("G101"))
+	})
+
+	It("should run the opt-in rules included by a filter", func() {
+		builders, _ := rules.Generate(false, rules.NewRuleFilter(false, "G124", "G101")).RulesInfo()
+		Expect(builders).Should(HaveLen(2))
+		Expect(builders).Should(HaveKey("G124"))
+	})
+
+	It("should not run the opt-in rules which are only not excluded", func() {
+		builders, _ := rules.Generate(false, rules.NewRuleFilter(true, "G101")).RulesInfo()
+		Expect(builders).ShouldNot(HaveKey("G124"))
+		Expect(builders).ShouldNot(HaveKey("G101"))
+	})
+
+	It("should run the opt-in rules enabled in their configuration", func() {
+		config := gosec.Config{"G124": map[string]interface{}{"enabled": true}}
+		ruleList, err := rules.GenerateWithCustomRules(config, false)
+		Expect(err).ShouldNot(HaveOccurred())
+		builders, _ := ruleList.RulesInfo()
+		Expect(builders).Should(HaveKey("G124"))
+		Expect(builders).ShouldNot(HaveKey("G127"))
+	})
+})
@@ -115,6 +115,98 @@ var _ = Describe("gosec rules", func() {
 			runner("G115", testutils.SampleCodeG115)
 		})
 
+		It("should detect insecure cookies", func() {
+			runner("G116", testutils.SampleCodeG116)
+		})
+
+		It("should detect http.Server without read or write timeouts", func() {
+			runner("G117", testutils.SampleCodeG117)
+		})
+
+		It("should detect permissive CORS policies", func() {
+			runner("G118", testutils.SampleCodeG118)
+		})
+
+		It("should detect potential ReDoS", func() {
+			runner("G119", testutils.SampleCodeG119)
+		})
+
+		It("should detect sensitive data written to the logs", func() {
+			runner("G120", testutils.SampleCodeG120)
+		})
+
+		It("should detect hardcoded cloud provider credentials", func() {
+			runner("G121", testutils.SampleCodeG121)
+		})
+
+		It("should detect open redirects", func() {
+			runner("G122", testutils.SampleCodeG122)
+		})
+
+		It("should detect unclosed HTTP response bodies", func() {
+			runner("G123", testutils.SampleCodeG123)
+		})
+
+		It("should detect HTTP handlers without security headers", func() {
+			runner("G124", testutils.SampleCodeG124)
+		})
+
+		It("should detect HTTP request bodies read without a size limit", func() {
+			runner("G125", testutils.SampleCodeG125)
+		})
+
+		It("should detect downloaded content used without an integrity check", func() {
+			runner("G127", testutils.SampleCodeG127)
+		})
+
+		It("should detect database connection strings with an embedded password", func() {
+			runner("G128", testutils.SampleCodeG128)
+		})
+
+		It("should detect type assertions without the comma-ok form on untrusted data", func() {
+			runner("G129", testutils.SampleCodeG129)
+		})
+
+		It("should detect secret environment variables passed to a subprocess or printed", func() {
+			runner("G130", testutils.SampleCodeG130)
+		})
+
+		It("should detect outbound HTTP requests and database queries without a context timeout", func() {
+			runner("G131", testutils.SampleCodeG131)
+		})
+
+		It("should detect goroutines which can panic without a deferred recover", func() {
+			runner("G132", testutils.SampleCodeG132)
+		})
+
+		It("should detect gRPC servers exposing debug services", func() {
+			runner("G133", testutils.SampleCodeG133)
+		})
+
+		It("should detect webhook handlers without signature verification", func() {
+			runner("G134", testutils.SampleCodeG134)
+		})
+
+		It("should detect subprocesses launched with a binary relative to PATH", func() {
+			runner("G135", testutils.SampleCodeG135)
+		})
+
+		It("should detect disabled Go module verification", func() {
+			runner("G136", testutils.SampleCodeG136)
+		})
+
+		It("should detect methods selected by reflection from user input", func() {
+			runner("G137", testutils.SampleCodeG137)
+		})
+
+		It("should detect request bodies decoded without DisallowUnknownFields", func() {
+			runner("G138", testutils.SampleCodeG138)
+		})
+
+		It("should detect request objects written to the logs", func() {
+			runner("G139", testutils.SampleCodeG139)
+		})
+
 		It("should detect sql injection via format strings", func() {
 			runner("G201", testutils.SampleCodeG201)
 		})
@@ -131,6 +223,30 @@ var _ = Describe("gosec rules", func() {
 			runner("G204", testutils.SampleCodeG204)
 		})
 
+		It("should detect XML external entity processing", func() {
+			runner("G205", testutils.SampleCodeG205)
+		})
+
+		It("should detect shell interpreters launched with a script", func() {
+			runner("G206", testutils.SampleCodeG206)
+		})
+
+		It("should detect untrusted data decoded into an interface", func() {
+			runner("G207", testutils.SampleCodeG207)
+		})
+
+		It("should detect format strings derived from user input", func() {
+			runner("G208", testutils.SampleCodeG208)
+		})
+
+		It("should detect user input converted to a trusted template type", func() {
+			runner("G209", testutils.SampleCodeG209)
+		})
+
+		It("should detect user input written into response headers", func() {
+			runner("G210", testutils.SampleCodeG210)
+		})
+
 		It("should detect poor file permissions on mkdir", func() {
 			runner("G301", testutils.SampleCodeG301)
 		})
@@ -155,6 +271,30 @@ var _ = Describe("gosec rules", func() {
 			runner("G306", testutils.SampleCodeG306)
 		})
 
+		It("should detect file path traversal when extracting tar archive", func() {
+			runner("G308", testutils.SampleCodeG308)
+		})
+
+		It("should detect temporary files created in a user-controlled or shared directory", func() {
+			runner("G309", testutils.SampleCodeG309)
+		})
+
+		It("should detect deferred Close calls on files opened for writing", func() {
+			runner("G310", testutils.SampleCodeG310)
+		})
+
+		It("should detect files written with group or world writable permissions", func() {
+			runner("G311", testutils.SampleCodeG311)
+		})
+
+		It("should detect secrets written to files readable by other users", func() {
+			runner("G312", testutils.SampleCodeG312)
+		})
+
+		It("should detect time-of-check to time-of-use races on files", func() {
+			runner("G313", testutils.SampleCodeG313)
+		})
+
 		It("should detect weak crypto algorithms", func() {
 			runner("G401", testutils.SampleCodeG401)
 		})
@@ -191,6 +331,74 @@ var _ = Describe("gosec rules", func() {
 			runner("G406", testutils.SampleCodeG406b)
 		})
 
+		It("should detect block ciphers used in ECB mode", func() {
+			runner("G407", testutils.SampleCodeG407)
+		})
+
+		It("should detect insecure JWT parsing", func() {
+			runner("G408", testutils.SampleCodeG408)
+		})
+
+		It("should detect hardcoded IV or nonce", func() {
+			runner("G409", testutils.SampleCodeG409)
+		})
+
+		It("should detect weak password hashing parameters", func() {
+			runner("G410", testutils.SampleCodeG410)
+		})
+
+		It("should detect insecure gRPC transport", func() {
+			runner("G411", testutils.SampleCodeG411)
+		})
+
+		It("should detect TLS certificate verification callbacks always returning nil", func() {
+			runner("G412", testutils.SampleCodeG412)
+		})
+
+		It("should detect secrets compared in non-constant time", func() {
+			runner("G413", testutils.SampleCodeG413)
+		})
+
+		It("should detect TLS hostname verification bypasses", func() {
+			runner("G414", testutils.SampleCodeG414)
+		})
+
+		It("should detect predictable seeds of the random number generator", func() {
+			runner("G415", testutils.SampleCodeG415)
+		})
+
+		It("should detect TLS configurations shared between a server and a client", func() {
+			runner("G416", testutils.SampleCodeG416)
+		})
+
+		It("should detect database connection strings which disable TLS", func() {
+			runner("G417", testutils.SampleCodeG417)
+		})
+
+		It("should detect weak elliptic curves and Diffie-Hellman groups", func() {
+			runner("G418", testutils.SampleCodeG418)
+		})
+
+		It("should detect ignored errors of crypto/rand reads", func() {
+			runner("G419", testutils.SampleCodeG419)
+		})
+
+		It("should detect JWTs signed with a hardcoded key", func() {
+			runner("G420", testutils.SampleCodeG420)
+		})
+
+		It("should detect HMACs computed with a weak hash function", func() {
+			runner("G421", testutils.SampleCodeG421)
+		})
+
+		It("should detect unchecked errors of cryptographic operations", func() {
+			runner("G422", testutils.SampleCodeG422)
+		})
+
+		It("should detect predictable UUIDs and tokens", func() {
+			runner("G423", testutils.SampleCodeG423)
+		})
+
 		It("should detect blocklisted imports - MD5", func() {
 			runner("G501", testutils.SampleCodeG501)
 		})
@@ -229,5 +437,9 @@ var _ = Describe("gosec rules", func() {
 		It("should detect out of bounds slice access", func() {
 			runner("G602", testutils.SampleCodeG602)
 		})
+
+		It("should detect unsafe pointer arithmetic", func() {
+			runner("G603", testutils.SampleCodeG603)
+		})
 	})
 })
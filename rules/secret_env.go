@@ -0,0 +1,167 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type secretEnvPropagation struct {
+	issue.MetaData
+	pattern  *regexp.Regexp
+	commands gosec.CallList
+	prints   gosec.CallList
+}
+
+func (r *secretEnvPropagation) ID() string {
+	return r.MetaData.ID
+}
+
+// secretEnv returns the name of the secret environment variable whose value is found in the
+// expression, either read directly or through variables
+func (r *secretEnvPropagation) secretEnv(expr ast.Expr, c *gosec.Context) (string, bool) {
+	var name string
+	secrets := taintSpec{
+		source: func(expr ast.Expr, c *gosec.Context) bool {
+			env, ok := sensitiveEnv(expr, r.pattern, c)
+			if ok && name == "" {
+				name = env
+			}
+			return ok
+		},
+	}
+	if !secrets.isTainted(expr, c) {
+		return "", false
+	}
+	return name, true
+}
+
+// isCmd checks if the expression is an exec.Cmd or a pointer to it
+func isCmd(expr ast.Expr, c *gosec.Context) bool {
+	t := c.Info.TypeOf(expr)
+	return t != nil && (t.String() == "*os/exec.Cmd" || t.String() == "os/exec.Cmd")
+}
+
+// untrustedCommand checks if the command, created by exec.Command and stored in a variable,
+// runs with arguments derived from user input
+func (r *secretEnvPropagation) untrustedCommand(expr ast.Expr, c *gosec.Context) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := c.Info.ObjectOf(ident)
+	if obj == nil {
+		return false
+	}
+	call, ok := assignedValue(obj, c).(*ast.CallExpr)
+	if !ok || r.commands.ContainsPkgCallExpr(call, c, false) == nil {
+		return false
+	}
+	for _, arg := range call.Args {
+		if isTainted(arg, c, nil) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *secretEnvPropagation) subprocessIssue(n ast.Node, env ast.Expr, untrusted bool, c *gosec.Context) *issue.Issue {
+	name, ok := r.secretEnv(env, c)
+	if !ok {
+		return nil
+	}
+	confidence := issue.Low
+	if untrusted {
+		confidence = issue.Medium
+	}
+	return c.NewIssue(n, r.ID(), fmt.Sprintf("Secret environment variable %s is passed to a subprocess", name), r.Severity, confidence)
+}
+
+func (r *secretEnvPropagation) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.AssignStmt:
+		for i, lhs := range node.Lhs {
+			sel, ok := lhs.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Env" || !isCmd(sel.X, c) || i >= len(node.Rhs) {
+				continue
+			}
+			if found := r.subprocessIssue(n, node.Rhs[i], r.untrustedCommand(sel.X, c), c); found != nil {
+				return found, nil
+			}
+		}
+	case *ast.CompositeLit:
+		if !isCmd(node, c) {
+			return nil, nil
+		}
+		var env ast.Expr
+		untrusted := false
+		for _, elt := range node.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				switch key.Name {
+				case "Env":
+					env = kv.Value
+				case "Path", "Args":
+					untrusted = untrusted || isTainted(kv.Value, c, nil)
+				}
+			}
+		}
+		if env != nil {
+			return r.subprocessIssue(n, env, untrusted, c), nil
+		}
+	case *ast.CallExpr:
+		if call := r.prints.ContainsPkgCallExpr(node, c, false); call != nil {
+			for _, arg := range call.Args {
+				if name, ok := r.secretEnv(arg, c); ok {
+					return c.NewIssue(n, r.ID(), fmt.Sprintf("Secret environment variable %s is printed", name), r.Severity, r.Confidence), nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewSecretEnvPropagation detects the values of the environment variables whose name looks
+// sensitive, which are passed to the environment of a subprocess or printed. The names are
+// matched with the same pattern as the hardcoded credentials, which can be changed with the
+// "pattern" from the rule configuration.
+func NewSecretEnvPropagation(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	settings := conf.RuleSettings(id)
+	commands := gosec.NewCallList()
+	commands.AddAll("os/exec", "Command", "CommandContext")
+
+	prints := gosec.NewCallList()
+	prints.AddAll("fmt", "Print", "Printf", "Println", "Fprint", "Fprintf", "Fprintln")
+
+	return &secretEnvPropagation{
+		pattern:  settings.Regexp("pattern", credentialsPattern),
+		commands: commands,
+		prints:   prints,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Secret environment variable propagated outside of the process",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.CompositeLit)(nil), (*ast.CallExpr)(nil)}
+}
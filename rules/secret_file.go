@@ -0,0 +1,175 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type secretFileWorldReadable struct {
+	issue.MetaData
+	mask    int64
+	pattern *regexp.Regexp
+}
+
+func (r *secretFileWorldReadable) ID() string {
+	return r.MetaData.ID
+}
+
+// secretName returns the name of the first identifier or field named after a secret in the
+// expression. The variables are followed to the values they are assigned.
+func (r *secretFileWorldReadable) secretName(expr ast.Expr, c *gosec.Context, visited map[types.Object]bool) (string, bool) {
+	var name string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if name != "" {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			// the length of a secret does not leak it
+			if fn, ok := node.Fun.(*ast.Ident); ok && (fn.Name == "len" || fn.Name == "cap") {
+				return false
+			}
+		case *ast.SelectorExpr:
+			if r.pattern.MatchString(node.Sel.Name) {
+				name = node.Sel.Name
+			}
+		case *ast.Ident:
+			if r.pattern.MatchString(node.Name) {
+				name = node.Name
+				return false
+			}
+			obj := c.Info.ObjectOf(node)
+			if _, ok := obj.(*types.Var); !ok || visited[obj] {
+				return false
+			}
+			visited[obj] = true
+			if value := assignedValue(obj, c); value != nil {
+				name, _ = r.secretName(value, c, visited)
+			}
+		}
+		return true
+	})
+	return name, name != ""
+}
+
+// writtenSecret returns the name of the secret written to the file opened by the call, looking
+// for the calls in the enclosing function which take the file either as receiver or as argument.
+func (r *secretFileWorldReadable) writtenSecret(open *ast.CallExpr, c *gosec.Context) (string, bool) {
+	body := enclosingFunc(open, c)
+	if body == nil {
+		return "", false
+	}
+	var file types.Object
+	ast.Inspect(body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Rhs) == 1 && assign.Rhs[0] == open {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+				file = c.Info.ObjectOf(ident)
+			}
+		}
+		return file == nil
+	})
+	if file == nil {
+		return "", false
+	}
+	isFile := func(expr ast.Expr) bool {
+		ident, ok := expr.(*ast.Ident)
+		return ok && c.Info.ObjectOf(ident) == file
+	}
+	var name string
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || name != "" {
+			return name == ""
+		}
+		writes := false
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isFile(sel.X) {
+			writes = true
+		}
+		var data []ast.Expr
+		for _, arg := range call.Args {
+			if isFile(arg) {
+				writes = true
+				continue
+			}
+			data = append(data, arg)
+		}
+		if !writes {
+			return true
+		}
+		for _, arg := range data {
+			if secret, ok := r.secretName(arg, c, map[types.Object]bool{}); ok {
+				name = secret
+				return false
+			}
+		}
+		return true
+	})
+	return name, name != ""
+}
+
+func (r *secretFileWorldReadable) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	var call *ast.CallExpr
+	var content ast.Expr
+	if wf, matched := gosec.MatchCallByPackage(n, c, "os", "WriteFile"); matched {
+		call, content = wf, wf.Args[1]
+	} else if wf, matched := gosec.MatchCallByPackage(n, c, "io/ioutil", "WriteFile"); matched {
+		call, content = wf, wf.Args[1]
+	} else if of, matched := gosec.MatchCallByPackage(n, c, "os", "OpenFile"); matched {
+		call = of
+	}
+	if call == nil || len(call.Args) != 3 {
+		return nil, nil
+	}
+	mode, ok := fileMode(call.Args[2], c)
+	if !ok || mode&r.mask == 0 {
+		return nil, nil
+	}
+	secret, found := r.secretName(call.Args[0], c, map[types.Object]bool{})
+	if !found && content != nil {
+		secret, found = r.secretName(content, c, map[types.Object]bool{})
+	}
+	if !found && content == nil {
+		secret, found = r.writtenSecret(call, c)
+	}
+	if !found {
+		return nil, nil
+	}
+	what := fmt.Sprintf("%s: %s written with permissions %#o", r.What, secret, mode)
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewSecretFileWorldReadable detects files holding secrets which are created with permissions
+// granting the group or the other users read access. A file is considered to hold a secret when
+// its path or the data written to it refers to a variable or a field named after a secret.
+func NewSecretFileWorldReadable(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	settings := conf.RuleSettings(id)
+	return &secretFileWorldReadable{
+		mask:    settings.Int("mask", 0o044),
+		pattern: settings.Regexp("pattern", credentialsPattern+`|private.?key`),
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Secret written to a file readable by other users",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,151 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const (
+	hstsHeader = "Strict-Transport-Security"
+	// hstsMinMaxAge is the minimum max-age of HSTS, one year in seconds
+	hstsMinMaxAge = 31536000
+)
+
+var (
+	defaultSecurityHeaders = []string{hstsHeader, "X-Content-Type-Options", "X-Frame-Options"}
+	hstsMaxAgePattern      = regexp.MustCompile(`(?i)max-age\s*=\s*"?(\d+)`)
+)
+
+type missingSecurityHeaders struct {
+	issue.MetaData
+	headers []string
+}
+
+func (r *missingSecurityHeaders) ID() string {
+	return r.MetaData.ID
+}
+
+// isHandlerFunc checks if the function has the signature of an http.HandlerFunc
+func isHandlerFunc(t types.Type) bool {
+	sig, ok := t.(*types.Signature)
+	if !ok || sig.Params().Len() != 2 || sig.Results().Len() != 0 {
+		return false
+	}
+	return sig.Params().At(0).Type().String() == "net/http.ResponseWriter" &&
+		sig.Params().At(1).Type().String() == "*net/http.Request"
+}
+
+// weakHSTS checks if the HSTS header is set with a constant max-age below one year
+func weakHSTS(value ast.Expr, c *gosec.Context) bool {
+	str, ok := constantString(value, c)
+	if !ok {
+		return false
+	}
+	match := hstsMaxAgePattern.FindStringSubmatch(str)
+	if match == nil {
+		return true
+	}
+	maxAge, err := strconv.ParseInt(match[1], 10, 64)
+	return err != nil || maxAge < hstsMinMaxAge
+}
+
+func (r *missingSecurityHeaders) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	var (
+		body   *ast.BlockStmt
+		fnType types.Type
+	)
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		if obj := c.Info.ObjectOf(fn.Name); obj != nil {
+			fnType = obj.Type()
+		}
+		body = fn.Body
+	case *ast.FuncLit:
+		fnType = c.Info.TypeOf(fn)
+		body = fn.Body
+	}
+	if body == nil || fnType == nil || !isHandlerFunc(fnType) {
+		return nil, nil
+	}
+
+	set := map[string]bool{}
+	weak := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if call, ok := headerSetCall(n, c); ok {
+			if name, ok := constantString(call.Args[0], c); ok {
+				name = http.CanonicalHeaderKey(name)
+				set[name] = true
+				if name == hstsHeader && weakHSTS(call.Args[1], c) {
+					weak = true
+				}
+			}
+		}
+		return true
+	})
+
+	var missing []string
+	for _, header := range r.headers {
+		if !set[header] {
+			missing = append(missing, header)
+		}
+	}
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing %s", strings.Join(missing, ", ")))
+	}
+	if weak {
+		problems = append(problems, fmt.Sprintf("%s max-age below one year", hstsHeader))
+	}
+	if len(problems) == 0 {
+		return nil, nil
+	}
+	what := fmt.Sprintf("%s: %s", r.What, strings.Join(problems, "; "))
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewMissingSecurityHeaders detects HTTP handlers which do not set the security headers, such
+// as HSTS.
+func NewMissingSecurityHeaders(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	settings := conf.RuleSettings(id)
+	headers := defaultSecurityHeaders
+	if cfgHeaders := settings.Strings("headers", nil); cfgHeaders != nil {
+		headers = make([]string, 0, len(cfgHeaders))
+		for _, header := range cfgHeaders {
+			headers = append(headers, http.CanonicalHeaderKey(header))
+		}
+	}
+	return &missingSecurityHeaders{
+		headers: headers,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Low,
+			What:       "HTTP handler does not set the security headers",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+}
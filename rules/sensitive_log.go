@@ -0,0 +1,143 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type sensitiveLog struct {
+	issue.MetaData
+	sinks      gosec.CallList
+	printSinks gosec.CallList
+	pattern    *regexp.Regexp
+}
+
+func (r *sensitiveLog) ID() string {
+	return r.MetaData.ID
+}
+
+// sensitiveEnv returns the name of the environment variable read by the call when the name matches the pattern
+func sensitiveEnv(expr ast.Expr, pattern *regexp.Regexp, c *gosec.Context) (string, bool) {
+	call, matched := gosec.MatchCallByPackage(expr, c, "os", "Getenv", "LookupEnv")
+	if !matched || len(call.Args) != 1 {
+		return "", false
+	}
+	tv, ok := c.Info.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	name := constant.StringVal(tv.Value)
+	return name, pattern.MatchString(name)
+}
+
+// sensitiveValue returns the name of the sensitive data found in the expression. The variables
+// and fields are matched by name, and the variables are traced back to the environment
+// variables they are read from.
+func (r *sensitiveLog) sensitiveValue(expr ast.Expr, c *gosec.Context) (string, bool) {
+	var name string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if name != "" {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			// the length of a secret does not leak it
+			if fn, ok := node.Fun.(*ast.Ident); ok && (fn.Name == "len" || fn.Name == "cap") {
+				return false
+			}
+			if env, ok := sensitiveEnv(node, r.pattern, c); ok {
+				name = env
+			}
+		case *ast.SelectorExpr:
+			if _, ok := c.Info.ObjectOf(node.Sel).(*types.Var); ok && r.pattern.MatchString(node.Sel.Name) {
+				name = node.Sel.Name
+			}
+		case *ast.Ident:
+			if _, ok := c.Info.ObjectOf(node).(*types.Var); !ok {
+				return true
+			}
+			if r.pattern.MatchString(node.Name) {
+				name = node.Name
+			} else if node.Obj != nil {
+				if assign, ok := node.Obj.Decl.(*ast.AssignStmt); ok && len(assign.Rhs) == 1 {
+					if env, ok := sensitiveEnv(assign.Rhs[0], r.pattern, c); ok {
+						name = env
+					}
+				}
+			}
+		}
+		return true
+	})
+	return name, name != ""
+}
+
+func (r *sensitiveLog) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.sinks.ContainsPkgCallExpr(n, c, false)
+	if call == nil {
+		// the fmt print functions are mostly producing the program output, hence they are only checked in audit mode
+		if enabled, err := c.Config.IsGlobalEnabled(gosec.Audit); err != nil || !enabled {
+			return nil, nil
+		}
+		if call = r.printSinks.ContainsPkgCallExpr(n, c, false); call == nil {
+			return nil, nil
+		}
+	}
+	for _, arg := range call.Args {
+		if name, ok := r.sensitiveValue(arg, c); ok {
+			return c.NewIssue(n, r.ID(), fmt.Sprintf("%s: %s", r.What, name), r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// logSinks returns the functions of the log and log/slog packages writing to the logs
+func logSinks() gosec.CallList {
+	logFuncs := []string{"Print", "Printf", "Println", "Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln"}
+	slogFuncs := []string{"Debug", "Info", "Warn", "Error", "DebugContext", "InfoContext", "WarnContext", "ErrorContext", "Log"}
+	sinks := gosec.NewCallList()
+	sinks.AddAll("log", logFuncs...)
+	sinks.AddAll("*log.Logger", logFuncs...)
+	sinks.AddAll("log/slog", slogFuncs...)
+	sinks.AddAll("*log/slog.Logger", slogFuncs...)
+	return sinks
+}
+
+// NewSensitiveLog detects sensitive data such as passwords or tokens written to the logs. The
+// names considered sensitive can be changed with the "pattern" from the rule configuration.
+// The fmt print functions are checked as well in audit mode.
+func NewSensitiveLog(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	printSinks := gosec.NewCallList()
+	printSinks.AddAll("fmt", "Print", "Printf", "Println", "Fprint", "Fprintf", "Fprintln")
+
+	return &sensitiveLog{
+		sinks:      logSinks(),
+		printSinks: printSinks,
+		pattern:    conf.RuleSettings(id).Regexp("pattern", credentialsPattern),
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Potential sensitive data written to the logs",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
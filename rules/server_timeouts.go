@@ -0,0 +1,95 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type serverTimeouts struct {
+	issue.MetaData
+	timeouts []string
+}
+
+func (r *serverTimeouts) ID() string {
+	return r.MetaData.ID
+}
+
+// configuredFields collects the fields set in the literal and the fields assigned later
+// on the variable holding it
+func configuredFields(lit *ast.CompositeLit, c *gosec.Context) map[string]bool {
+	fields := map[string]bool{}
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				fields[key.Name] = true
+			}
+		}
+	}
+	obj := assignedVariable(lit, c)
+	if obj == nil {
+		return fields
+	}
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				if sel, ok := lhs.(*ast.SelectorExpr); ok {
+					if ident, ok := sel.X.(*ast.Ident); ok && c.Info.ObjectOf(ident) == obj {
+						fields[sel.Sel.Name] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+	return fields
+}
+
+func (r *serverTimeouts) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	lit := gosec.MatchCompLit(n, c, "net/http.Server")
+	// servers without ReadHeaderTimeout are already reported by the slowloris rule
+	if lit == nil || len(r.timeouts) == 0 || !containsReadHeaderTimeout(lit) {
+		return nil, nil
+	}
+	fields := configuredFields(lit, c)
+	for _, timeout := range r.timeouts {
+		if fields[timeout] {
+			return nil, nil
+		}
+	}
+	what := fmt.Sprintf("%s: %s not configured in the http.Server", r.What, strings.Join(r.timeouts, ", "))
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewServerTimeouts detects http.Server values which configure none of the ReadTimeout and WriteTimeout.
+// The list of timeouts can be changed with the "timeouts" list from the rule configuration.
+func NewServerTimeouts(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	timeouts := []string{"ReadTimeout", "WriteTimeout"}
+	timeouts = conf.RuleSettings(id).Strings("timeouts", timeouts)
+	return &serverTimeouts{
+		timeouts: timeouts,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Potential resource exhaustion because of missing timeouts",
+		},
+	}, []ast.Node{(*ast.CompositeLit)(nil)}
+}
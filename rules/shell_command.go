@@ -0,0 +1,122 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"path"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// shellFlags maps the shell interpreters to the flag which runs the script given as next argument
+var shellFlags = map[string][]string{
+	"sh":             {"-c"},
+	"bash":           {"-c"},
+	"zsh":            {"-c"},
+	"dash":           {"-c"},
+	"ksh":            {"-c"},
+	"cmd":            {"/c", "/k"},
+	"cmd.exe":        {"/c", "/k"},
+	"powershell":     {"-c", "-command"},
+	"powershell.exe": {"-c", "-command"},
+	"pwsh":           {"-c", "-command"},
+	"pwsh.exe":       {"-c", "-command"},
+}
+
+// constantString returns the value of a constant string expression
+func constantString(expr ast.Expr, c *gosec.Context) (string, bool) {
+	tv, ok := c.Info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// shellScript returns the script argument when the command arguments run a
+// script through a shell interpreter, e.g. sh -c script
+func shellScript(args []ast.Expr, c *gosec.Context) (ast.Expr, bool) {
+	if len(args) < 3 {
+		return nil, false
+	}
+	name, ok := constantString(args[0], c)
+	if !ok {
+		return nil, false
+	}
+	flags, ok := shellFlags[strings.ToLower(path.Base(strings.ReplaceAll(name, "\\", "/")))]
+	if !ok {
+		return nil, false
+	}
+	for i, arg := range args[1 : len(args)-1] {
+		flag, ok := constantString(arg, c)
+		if !ok {
+			return nil, false
+		}
+		for _, f := range flags {
+			if strings.EqualFold(flag, f) {
+				return args[i+2], true
+			}
+		}
+	}
+	return nil, false
+}
+
+type shellCommand struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *shellCommand) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *shellCommand) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	node := r.calls.ContainsPkgCallExpr(n, c, false)
+	if node == nil {
+		return nil, nil
+	}
+	args := node.Args
+	if _, name, err := gosec.GetCallInfo(node, c); err == nil && name == "CommandContext" && len(args) > 0 {
+		args = args[1:]
+	}
+	script, ok := shellScript(args, c)
+	if !ok {
+		return nil, nil
+	}
+	if gosec.TryResolve(script, c) {
+		return c.NewIssue(n, r.ID(), "Shell interpreter launched with a constant script", r.Severity, issue.Low), nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewShellCommand detects commands which run a script through a shell interpreter,
+// e.g. exec.Command("sh", "-c", script), since the script is prone to injection.
+func NewShellCommand(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("os/exec", "Command", "CommandContext")
+	calls.AddAll("golang.org/x/sys/execabs", "Command", "CommandContext")
+	return &shellCommand{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.High,
+			Confidence: issue.High,
+			What:       "Shell interpreter launched with a non-constant script",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
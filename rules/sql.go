@@ -268,9 +268,12 @@ func (s *sqlStrFormat) checkQuery(call *ast.CallExpr, ctx *gosec.Context) (*issu
 		return nil, err
 	}
 
-	if ident, ok := query.(*ast.Ident); ok && ident.Obj != nil {
-		decl := ident.Obj.Decl
-		if assign, ok := decl.(*ast.AssignStmt); ok {
+	switch query := query.(type) {
+	case *ast.Ident:
+		if query.Obj == nil {
+			break
+		}
+		if assign, ok := query.Obj.Decl.(*ast.AssignStmt); ok {
 			for _, expr := range assign.Rhs {
 				issue := s.checkFormatting(expr, ctx)
 				if issue != nil {
@@ -278,6 +281,9 @@ func (s *sqlStrFormat) checkQuery(call *ast.CallExpr, ctx *gosec.Context) (*issu
 				}
 			}
 		}
+	case *ast.CallExpr:
+		// the query is formatted inline, e.g. db.QueryContext(ctx, fmt.Sprintf(...))
+		return s.checkFormatting(query, ctx), nil
 	}
 
 	return nil, nil
@@ -11,6 +11,9 @@ import (
 type ssrf struct {
 	issue.MetaData
 	gosec.CallList
+	dials    gosec.CallList
+	clients  gosec.CallList
+	requests gosec.CallList
 }
 
 // ID returns the identifier for this rule
@@ -40,21 +43,106 @@ func (r *ssrf) ResolveVar(n *ast.CallExpr, c *gosec.Context) bool {
 	return false
 }
 
+// addressIndex returns the position of the address argument of a dial function
+func addressIndex(name string) int {
+	if name == "DialContext" {
+		return 2
+	}
+	return 1
+}
+
+// urlInput tracks the user input reaching a URL. Unlike the generic taint tracking, a
+// url.URL is not tainted on its own, so that a URL parsed from a constant is considered safe.
+var urlInput = func() taintSpec {
+	spec := userInput
+	spec.types = map[string]bool{}
+	for name := range taintedTypes {
+		if name != "*net/url.URL" {
+			spec.types[name] = true
+		}
+	}
+	return spec
+}()
+
+// requestURL returns the URL of the request sent by a client, when the request is built
+// either with http.NewRequest or with a http.Request literal
+func (r *ssrf) requestURL(expr ast.Expr, c *gosec.Context, visited map[types.Object]bool) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.requestURL(e.X, c, visited)
+	case *ast.UnaryExpr:
+		return r.requestURL(e.X, c, visited)
+	case *ast.Ident:
+		obj := c.Info.ObjectOf(e)
+		if obj == nil || visited[obj] {
+			return nil
+		}
+		visited[obj] = true
+		if value := assignedValue(obj, c); value != nil {
+			return r.requestURL(value, c, visited)
+		}
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "URL" {
+					return kv.Value
+				}
+			}
+		}
+	case *ast.CallExpr:
+		if call := r.requests.ContainsPkgCallExpr(e, c, false); call != nil {
+			_, name, err := gosec.GetCallInfo(call, c)
+			if err != nil {
+				return nil
+			}
+			idx := 1
+			if name == "NewRequestWithContext" {
+				idx = 2
+			}
+			if idx < len(call.Args) {
+				return call.Args[idx]
+			}
+		}
+	}
+	return nil
+}
+
 // Match inspects AST nodes to determine if certain net/http methods are called with variable input
 func (r *ssrf) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 	// Call expression is using http package directly
 	if node := r.ContainsPkgCallExpr(n, c, false); node != nil {
 		if r.ResolveVar(node, c) {
-			return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+			return withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), node.Args[0], c, nil), nil
+		}
+	}
+	// Request sent by a client
+	if node := r.clients.ContainsPkgCallExpr(n, c, false); node != nil && len(node.Args) == 1 {
+		if url := r.requestURL(node.Args[0], c, map[types.Object]bool{}); url != nil && urlInput.isTainted(url, c) {
+			return urlInput.withTaintTrail(c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), url, c), nil
+		}
+	}
+	// Network connection opened to an address
+	if node := r.dials.ContainsPkgCallExpr(n, c, false); node != nil {
+		_, name, err := gosec.GetCallInfo(node, c)
+		if err != nil {
+			return nil, nil
+		}
+		if idx := addressIndex(name); idx < len(node.Args) && urlInput.isTainted(node.Args[idx], c) {
+			i := c.NewIssue(n, r.ID(), "Potential network connection made to a user-controlled address", r.Severity, r.Confidence)
+			return urlInput.withTaintTrail(i, node.Args[idx], c), nil
 		}
 	}
 	return nil, nil
 }
 
-// NewSSRFCheck detects cases where HTTP requests are sent
+// NewSSRFCheck detects cases where HTTP requests are sent or network connections are
+// opened to an address which may be controlled by the user
 func NewSSRFCheck(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
 	rule := &ssrf{
 		CallList: gosec.NewCallList(),
+		dials:    gosec.NewCallList(),
+		clients:  gosec.NewCallList(),
+		requests: gosec.NewCallList(),
 		MetaData: issue.MetaData{
 			ID:         id,
 			What:       "Potential HTTP request made with variable url",
@@ -63,5 +151,13 @@ func NewSSRFCheck(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
 		},
 	}
 	rule.AddAll("net/http", "Do", "Get", "Head", "Post", "PostForm", "RoundTrip")
+	rule.dials.AddAll("net", "Dial", "DialTimeout")
+	// The methods with a pointer receiver are also called on addressable values
+	for _, ptr := range []string{"", "*"} {
+		rule.dials.AddAll(ptr+"net.Dialer", "Dial", "DialContext")
+		rule.clients.Add(ptr+"net/http.Client", "Do")
+		rule.clients.Add(ptr+"net/http.Transport", "RoundTrip")
+	}
+	rule.requests.AddAll("net/http", "NewRequest", "NewRequestWithContext")
 	return rule, []ast.Node{(*ast.CallExpr)(nil)}
 }
@@ -25,6 +25,7 @@ import (
 type subprocess struct {
 	issue.MetaData
 	gosec.CallList
+	shellScripts bool
 }
 
 func (r *subprocess) ID() string {
@@ -46,7 +47,16 @@ func (r *subprocess) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 		if r.isContext(n, c) {
 			args = args[1:]
 		}
+		// The scripts run through a shell interpreter are reported by the shell command rule,
+		// unless it is excluded from the analysis.
+		var script ast.Expr
+		if !r.shellScripts {
+			script, _ = shellScript(args, c)
+		}
 		for _, arg := range args {
+			if arg == script {
+				continue
+			}
 			if ident, ok := arg.(*ast.Ident); ok {
 				obj := c.Info.ObjectOf(ident)
 
@@ -65,7 +75,7 @@ func (r *subprocess) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 						_, assignment := ident.Obj.Decl.(*ast.AssignStmt)
 						if variable && assignment {
 							if !gosec.TryResolve(ident, c) {
-								return c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), nil
+								return withTaintTrail(c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), arg, c, nil), nil
 							}
 						}
 					case *ast.Field:
@@ -75,21 +85,21 @@ func (r *subprocess) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
 							vv, vvok := obj.(*types.Var)
 
 							if vvok && vv.Parent().Lookup(ident.Name) == nil {
-								return c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), nil
+								return withTaintTrail(c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), arg, c, nil), nil
 							}
 						}
 					case *ast.ValueSpec:
 						_, valueSpec := ident.Obj.Decl.(*ast.ValueSpec)
 						if variable && valueSpec {
 							if !gosec.TryResolve(ident, c) {
-								return c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), nil
+								return withTaintTrail(c.NewIssue(n, r.ID(), "Subprocess launched with variable", issue.Medium, issue.High), arg, c, nil), nil
 							}
 						}
 					}
 				}
 			} else if !gosec.TryResolve(arg, c) {
 				// the arg is not a constant or a variable but instead a function call or os.Args[i]
-				return c.NewIssue(n, r.ID(), "Subprocess launched with a potential tainted input or cmd arguments", issue.Medium, issue.High), nil
+				return withTaintTrail(c.NewIssue(n, r.ID(), "Subprocess launched with a potential tainted input or cmd arguments", issue.Medium, issue.High), arg, c, nil), nil
 			}
 		}
 	}
@@ -109,9 +119,10 @@ func (r *subprocess) isContext(n ast.Node, ctx *gosec.Context) bool {
 	return false
 }
 
-// NewSubproc detects cases where we are forking out to an external process
-func NewSubproc(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
-	rule := &subprocess{issue.MetaData{ID: id}, gosec.NewCallList()}
+// NewSubproc detects cases where we are forking out to an external process. The scripts run
+// through a shell interpreter are left to G206, unless G206 is excluded from the analysis.
+func NewSubproc(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	rule := &subprocess{issue.MetaData{ID: id}, gosec.NewCallList(), conf.IsRuleExcluded("G206")}
 	rule.Add("os/exec", "Command")
 	rule.Add("os/exec", "CommandContext")
 	rule.Add("syscall", "Exec")
@@ -0,0 +1,293 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// taintedTypes lists the types whose values are controlled by the user
+var taintedTypes = map[string]bool{
+	"*net/http.Request": true,
+	"net/http.Header":   true,
+	"*net/url.URL":      true,
+	"net/url.Values":    true,
+}
+
+// taintSources lists the functions returning values controlled by the user
+var taintSources = func() gosec.CallList {
+	calls := gosec.NewCallList()
+	calls.AddAll("os", "Getenv", "LookupEnv")
+	calls.AddAll("flag", "Arg", "Args", "String")
+	return calls
+}()
+
+// taintVars lists the package variables holding values controlled by the user
+var taintVars = func() gosec.CallList {
+	vars := gosec.NewCallList()
+	vars.Add("os", "Args")
+	return vars
+}()
+
+// taintSpec describes what a taint analysis tracks. The values of the listed types, the
+// results of the sources, the listed package variables and the expressions matched by the
+// source function are tainted, as well as the variables filled by the matched calls. The
+// results of the sanitizers are safe.
+type taintSpec struct {
+	types      map[string]bool
+	sources    gosec.CallList
+	vars       gosec.CallList
+	source     func(ast.Expr, *gosec.Context) bool
+	fills      func(*ast.CallExpr, *gosec.Context) bool
+	sanitizers gosec.CallList
+}
+
+// userInput tracks the values derived from HTTP requests, the command line arguments or
+// the environment variables
+var userInput = taintSpec{types: taintedTypes, sources: taintSources, vars: taintVars}
+
+// taintTracker follows values back through the variable assignments of the file to find
+// out if they are derived from one of the sources of its spec. A value passed through one
+// of the sanitizers is considered safe.
+type taintTracker struct {
+	taintSpec
+	visited map[types.Object]bool
+	trail   []ast.Node
+}
+
+// isTainted reports whether the expression is derived from user input
+func isTainted(expr ast.Expr, c *gosec.Context, sanitizers gosec.CallList) bool {
+	return userInput.sanitizedBy(sanitizers).isTainted(expr, c)
+}
+
+// taintTrail reports whether the expression is derived from user input and returns the
+// statements through which the user input was assigned, ordered from the source to the sink
+func taintTrail(expr ast.Expr, c *gosec.Context, sanitizers gosec.CallList) ([]ast.Node, bool) {
+	return userInput.sanitizedBy(sanitizers).taintTrail(expr, c)
+}
+
+// withTaintTrail adds the statements followed by the tainted expression to the related
+// locations of the issue
+func withTaintTrail(i *issue.Issue, expr ast.Expr, c *gosec.Context, sanitizers gosec.CallList) *issue.Issue {
+	return userInput.sanitizedBy(sanitizers).withTaintTrail(i, expr, c)
+}
+
+// sanitizedBy returns a copy of the spec with the given sanitizers
+func (s taintSpec) sanitizedBy(sanitizers gosec.CallList) taintSpec {
+	s.sanitizers = sanitizers
+	return s
+}
+
+// withSources returns a copy of the spec which also considers the results of the given
+// functions as tainted
+func (s taintSpec) withSources(pkg string, names ...string) taintSpec {
+	sources := gosec.NewCallList()
+	for selector, idents := range s.sources {
+		for ident := range idents {
+			sources.Add(selector, ident)
+		}
+	}
+	sources.AddAll(pkg, names...)
+	s.sources = sources
+	return s
+}
+
+// isTainted reports whether the expression is derived from one of the sources of the spec
+func (s taintSpec) isTainted(expr ast.Expr, c *gosec.Context) bool {
+	_, tainted := s.taintTrail(expr, c)
+	return tainted
+}
+
+// taintTrail reports whether the expression is derived from one of the sources of the spec
+// and returns the statements through which the tainted value was assigned, ordered from the
+// source to the sink
+func (s taintSpec) taintTrail(expr ast.Expr, c *gosec.Context) ([]ast.Node, bool) {
+	t := &taintTracker{taintSpec: s, visited: map[types.Object]bool{}}
+	if !t.tainted(expr, c) {
+		return nil, false
+	}
+	return t.trail, true
+}
+
+// withTaintTrail adds the statements followed by the tainted expression to the related
+// locations of the issue
+func (s taintSpec) withTaintTrail(i *issue.Issue, expr ast.Expr, c *gosec.Context) *issue.Issue {
+	trail, _ := s.taintTrail(expr, c)
+	if len(trail) == 0 {
+		return i
+	}
+	locations := make([]issue.Location, 0, len(trail))
+	for idx, node := range trail {
+		message := "Tainted value propagated"
+		if idx == 0 {
+			message = "Source of the tainted value"
+		}
+		locations = append(locations, c.NewLocation(node, message))
+	}
+	return i.WithRelatedLocations(locations)
+}
+
+func (t *taintTracker) tainted(expr ast.Expr, c *gosec.Context) bool {
+	if expr == nil {
+		return false
+	}
+	if tv, ok := c.Info.Types[expr]; ok {
+		if tv.Value != nil {
+			return false
+		}
+		if tv.Type != nil && t.types[tv.Type.String()] {
+			return true
+		}
+	}
+	if t.source != nil && t.source(expr, c) {
+		return true
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return t.taintedVar(e, c)
+	case *ast.ParenExpr:
+		return t.tainted(e.X, c)
+	case *ast.StarExpr:
+		return t.tainted(e.X, c)
+	case *ast.UnaryExpr:
+		return t.tainted(e.X, c)
+	case *ast.SliceExpr:
+		return t.tainted(e.X, c)
+	case *ast.IndexExpr:
+		return t.tainted(e.X, c)
+	case *ast.TypeAssertExpr:
+		return t.tainted(e.X, c)
+	case *ast.BinaryExpr:
+		return t.tainted(e.X, c) || t.tainted(e.Y, c)
+	case *ast.SelectorExpr:
+		if path, ok := selectorPkg(e, c); ok {
+			return t.vars != nil && t.vars.Contains(path, e.Sel.Name)
+		}
+		return t.tainted(e.X, c)
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if t.tainted(elt, c) {
+				return true
+			}
+		}
+	case *ast.CallExpr:
+		return t.taintedCall(e, c)
+	}
+	return false
+}
+
+// taintedCall checks if the result of the call is derived from user input. The result of a call
+// is tainted when the function is a source, or when the receiver or any argument is tainted.
+func (t *taintTracker) taintedCall(call *ast.CallExpr, c *gosec.Context) bool {
+	if t.sanitizers != nil && t.sanitizers.ContainsPkgCallExpr(call, c, false) != nil {
+		return false
+	}
+	if t.sources != nil && t.sources.ContainsPkgCallExpr(call, c, false) != nil {
+		return true
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if _, isPkg := selectorPkg(sel, c); !isPkg && t.tainted(sel.X, c) {
+			return true
+		}
+	}
+	for _, arg := range call.Args {
+		if t.tainted(arg, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorPkg returns the import path of the package when the selector refers to a package member
+func selectorPkg(sel *ast.SelectorExpr, c *gosec.Context) (string, bool) {
+	if ident, ok := sel.X.(*ast.Ident); ok {
+		if pkg, ok := c.Info.ObjectOf(ident).(*types.PkgName); ok {
+			return pkg.Imported().Path(), true
+		}
+	}
+	return "", false
+}
+
+// taintedVar checks the values assigned to the variable across the file, and the calls
+// filling the variable through its address
+func (t *taintTracker) taintedVar(ident *ast.Ident, c *gosec.Context) bool {
+	obj, ok := c.Info.ObjectOf(ident).(*types.Var)
+	if !ok || t.visited[obj] {
+		return false
+	}
+	t.visited[obj] = true
+	if t.types[obj.Type().String()] {
+		return true
+	}
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				if lhsIdent, ok := lhs.(*ast.Ident); !ok || c.Info.ObjectOf(lhsIdent) != obj {
+					continue
+				}
+				if len(node.Lhs) == len(node.Rhs) {
+					found = t.tainted(node.Rhs[i], c)
+				} else if len(node.Rhs) == 1 {
+					found = t.tainted(node.Rhs[0], c)
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range node.Names {
+				if c.Info.ObjectOf(name) != obj {
+					continue
+				}
+				if len(node.Names) == len(node.Values) {
+					found = t.tainted(node.Values[i], c)
+				} else if len(node.Values) == 1 {
+					found = t.tainted(node.Values[0], c)
+				}
+			}
+		case *ast.RangeStmt:
+			for _, v := range []ast.Expr{node.Key, node.Value} {
+				if v, ok := v.(*ast.Ident); ok && c.Info.ObjectOf(v) == obj {
+					found = t.tainted(node.X, c)
+				}
+			}
+		case *ast.CallExpr:
+			if t.fills != nil && len(node.Args) > 0 && t.fills(node, c) {
+				target := node.Args[len(node.Args)-1]
+				if unary, ok := target.(*ast.UnaryExpr); ok {
+					target = unary.X
+				}
+				if ident, ok := target.(*ast.Ident); ok && c.Info.ObjectOf(ident) == obj {
+					found = true
+				}
+			}
+		}
+		if found {
+			// the nested assignments are recorded first, so the trail starts at the source
+			t.trail = append(t.trail, n)
+		}
+		return !found
+	})
+	return found
+}
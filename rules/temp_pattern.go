@@ -0,0 +1,71 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"regexp"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type predictableTempPattern struct {
+	issue.MetaData
+	calls      gosec.CallList
+	sharedDirs *regexp.Regexp
+}
+
+func (r *predictableTempPattern) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *predictableTempPattern) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) < 2 {
+		return nil, nil
+	}
+	dir := call.Args[0]
+	if path, ok := constantString(dir, c); ok {
+		if r.sharedDirs.MatchString(path) {
+			return c.NewIssue(n, r.ID(), "Temporary file created in a world-writable directory", r.Severity, issue.Medium), nil
+		}
+		return nil, nil
+	}
+	if isTainted(dir, c, nil) {
+		return withTaintTrail(c.NewIssue(n, r.ID(), "Temporary file created in a directory controlled by the user", r.Severity, issue.High), dir, c, nil), nil
+	}
+	return nil, nil
+}
+
+// NewPredictableTempPattern detects temporary files created with os.CreateTemp or ioutil.TempFile
+// in a directory which is either controlled by the user or a hardcoded world-writable location.
+// The empty directory, which selects the default temporary directory, is considered safe. The
+// direct creation of files with a predictable path is reported by G303.
+func NewPredictableTempPattern(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.Add("os", "CreateTemp")
+	calls.Add("io/ioutil", "TempFile")
+	return &predictableTempPattern{
+		calls:      calls,
+		sharedDirs: regexp.MustCompile(`^((/(usr|var))?/tmp|/dev/shm)(/.*)?$`),
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Temporary file created in a user-controlled or shared directory",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
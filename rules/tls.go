@@ -90,7 +90,8 @@ func (t *insecureConfigTLS) processTLSConfVal(key ast.Expr, value ast.Expr, c *g
 		case "InsecureSkipVerify":
 			if node, ok := value.(*ast.Ident); ok {
 				if node.Name != "false" {
-					return c.NewIssue(value, t.ID(), "TLS InsecureSkipVerify set true.", issue.High, issue.High)
+					return c.NewIssue(value, t.ID(), "TLS InsecureSkipVerify set true.", issue.High, issue.High).
+						WithAutofix(c.NewFix(value, "Enable the verification of the server certificate", "false"))
 				}
 			} else {
 				// TODO(tk): symbol tab look up to get the actual value
@@ -169,6 +170,16 @@ func (t *insecureConfigTLS) processTLSConfVal(key ast.Expr, value ast.Expr, c *g
 				return ret
 			}
 
+		case "Renegotiation":
+			if se, ok := value.(*ast.SelectorExpr); ok && se.Sel.Name == "RenegotiateFreelyAsClient" {
+				if pkg, ok := se.X.(*ast.Ident); ok {
+					if ip, ok := gosec.GetImportPath(pkg.Name, c); ok && ip == "crypto/tls" {
+						return c.NewIssue(value, t.ID(), "TLS Renegotiation allowed freely as client.", issue.Medium, issue.High).
+							WithAutofix(c.NewFix(value, "Disable the TLS renegotiation", pkg.Name+".RenegotiateNever"))
+					}
+				}
+			}
+
 		}
 	}
 	return nil
@@ -0,0 +1,222 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type tlsHostnameVerify struct {
+	issue.MetaData
+	dials gosec.CallList
+}
+
+func (r *tlsHostnameVerify) ID() string {
+	return r.MetaData.ID
+}
+
+// isEmptyString checks if the expression is the constant empty string
+func isEmptyString(expr ast.Expr, c *gosec.Context) bool {
+	value, ok := constantString(expr, c)
+	return ok && value == ""
+}
+
+// isTLSConfig checks if the type is tls.Config or a pointer to it
+func isTLSConfig(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return t != nil && t.String() == "crypto/tls.Config"
+}
+
+// isTransport checks if the type is http.Transport or a pointer to it
+func isTransport(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return t != nil && t.String() == "net/http.Transport"
+}
+
+// isRawDial checks if the expression is a plain network connection, either dialed
+// in place or assigned from a dial call
+func (r *tlsHostnameVerify) isRawDial(expr ast.Expr, c *gosec.Context) bool {
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj := c.Info.ObjectOf(ident)
+		if obj == nil {
+			return false
+		}
+		if expr = assignedValue(obj, c); expr == nil {
+			return false
+		}
+	}
+	return r.dials.ContainsPkgCallExpr(expr, c, false) != nil
+}
+
+// returnsRawConn checks if a TLS dial function returns a connection which was not
+// wrapped by a TLS client
+func (r *tlsHostnameVerify) returnsRawConn(fn ast.Expr, c *gosec.Context) bool {
+	_, body := resolveFunc(fn, c)
+	if body == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(node.Results) > 0 && r.isRawDial(node.Results[0], c) {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// unwrapConfig removes the parentheses and the address operator around a TLS configuration
+func unwrapConfig(expr ast.Expr) ast.Expr {
+	switch node := expr.(type) {
+	case *ast.ParenExpr:
+		return unwrapConfig(node.X)
+	case *ast.UnaryExpr:
+		if node.Op == token.AND {
+			return unwrapConfig(node.X)
+		}
+	}
+	return expr
+}
+
+// holderOf returns the variable to which the TLS configuration literal is assigned
+func holderOf(lit ast.Expr, c *gosec.Context) types.Object {
+	var holder types.Object
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if holder != nil {
+			return false
+		}
+		var names []ast.Expr
+		var values []ast.Expr
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			names, values = node.Lhs, node.Rhs
+		case *ast.ValueSpec:
+			for _, name := range node.Names {
+				names = append(names, name)
+			}
+			values = node.Values
+		default:
+			return true
+		}
+		for i, value := range values {
+			if i < len(names) && unwrapConfig(value) == lit {
+				if ident, ok := names[i].(*ast.Ident); ok {
+					holder = c.Info.ObjectOf(ident)
+				}
+			}
+		}
+		return true
+	})
+	return holder
+}
+
+// reachesClient checks if the TLS configuration, given either as a literal or as the
+// variable holding it, is used by a TLS client
+func reachesClient(config ast.Expr, c *gosec.Context) bool {
+	lit := unwrapConfig(config)
+	holder := configVariable(config, c)
+	if holder == nil {
+		holder = holderOf(lit, c)
+	}
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		used, role := tlsConfigUse(n, c)
+		if role != clientTLSRole {
+			return true
+		}
+		if unwrapConfig(used) == lit || (holder != nil && configVariable(used, c) == holder) {
+			found = true
+		}
+		return true
+	})
+	return found
+}
+
+func (r *tlsHostnameVerify) checkField(n ast.Node, config ast.Expr, field string, value ast.Expr, c *gosec.Context) *issue.Issue {
+	owner := c.Info.TypeOf(config)
+	switch {
+	case field == "ServerName" && isTLSConfig(owner) && isEmptyString(value, c) && reachesClient(config, c):
+		return c.NewIssue(n, r.ID(), "TLS ServerName is explicitly set to an empty string, the server hostname is not verified against the certificate", r.Severity, r.Confidence)
+	case (field == "DialTLS" || field == "DialTLSContext") && isTransport(owner) && r.returnsRawConn(value, c):
+		return c.NewIssue(n, r.ID(), "Custom TLS dial function returns a plain network connection, the server certificate is not verified", r.Severity, r.Confidence)
+	}
+	return nil
+}
+
+func (r *tlsHostnameVerify) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.CompositeLit:
+		for _, elt := range node.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok {
+					if found := r.checkField(kv, node, key.Name, kv.Value, c); found != nil {
+						return found, nil
+					}
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		if len(node.Lhs) != len(node.Rhs) {
+			return nil, nil
+		}
+		for i, lhs := range node.Lhs {
+			if sel, ok := lhs.(*ast.SelectorExpr); ok {
+				if found := r.checkField(node, sel.X, sel.Sel.Name, node.Rhs[i], c); found != nil {
+					return found, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewTLSHostnameVerify detects TLS client configurations which skip the verification of
+// the server hostname without setting InsecureSkipVerify, either with an explicitly empty
+// ServerName on a configuration used by a TLS client or with a custom TLS dial function returning a plain network connection.
+func NewTLSHostnameVerify(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	dials := gosec.NewCallList()
+	dials.AddAll("net", "Dial", "DialTimeout")
+	dials.AddAll("*net.Dialer", "Dial", "DialContext")
+	dials.AddAll("net.Dialer", "Dial", "DialContext")
+	return &tlsHostnameVerify{
+		dials: dials,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "TLS hostname verification is bypassed",
+		},
+	}, []ast.Node{(*ast.CompositeLit)(nil), (*ast.AssignStmt)(nil)}
+}
@@ -0,0 +1,181 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type tlsRole int
+
+const (
+	noTLSRole tlsRole = iota
+	serverTLSRole
+	clientTLSRole
+)
+
+// tlsServerCalls lists the functions using their last argument as a server TLS configuration
+var tlsServerCalls = func() gosec.CallList {
+	calls := gosec.NewCallList()
+	calls.AddAll("crypto/tls", "Listen", "NewListener", "Server")
+	return calls
+}()
+
+// tlsClientCalls lists the functions using their last argument as a client TLS configuration
+var tlsClientCalls = func() gosec.CallList {
+	calls := gosec.NewCallList()
+	calls.AddAll("crypto/tls", "Dial", "DialWithDialer", "Client")
+	return calls
+}()
+
+type sharedTLSConfig struct {
+	issue.MetaData
+}
+
+func (r *sharedTLSConfig) ID() string {
+	return r.MetaData.ID
+}
+
+// hasType checks if the type of the expression, or the type it points to, is the given type
+func hasType(expr ast.Expr, name string, c *gosec.Context) bool {
+	t := c.Info.TypeOf(expr)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	return t != nil && t.String() == name
+}
+
+// fieldRole returns the role of the TLS configuration set on the field of the given type
+func fieldRole(field string, expr ast.Expr, c *gosec.Context) tlsRole {
+	switch {
+	case field == "TLSConfig" && hasType(expr, "net/http.Server", c):
+		return serverTLSRole
+	case field == "TLSClientConfig" && hasType(expr, "net/http.Transport", c),
+		field == "Config" && hasType(expr, "crypto/tls.Dialer", c):
+		return clientTLSRole
+	}
+	return noTLSRole
+}
+
+// configVariable returns the variable holding the TLS configuration, referenced directly
+// or through its address
+func configVariable(expr ast.Expr, c *gosec.Context) types.Object {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok || !isTLSConfig(c.Info.TypeOf(ident)) {
+		return nil
+	}
+	if obj, ok := c.Info.ObjectOf(ident).(*types.Var); ok {
+		return obj
+	}
+	return nil
+}
+
+// tlsConfigUse returns the TLS configuration used by the node and whether it is used
+// by a server or by a client
+func tlsConfigUse(n ast.Node, c *gosec.Context) (ast.Expr, tlsRole) {
+	switch node := n.(type) {
+	case *ast.CallExpr:
+		if len(node.Args) == 0 {
+			return nil, noTLSRole
+		}
+		config := node.Args[len(node.Args)-1]
+		if tlsServerCalls.ContainsPkgCallExpr(node, c, false) != nil {
+			return config, serverTLSRole
+		}
+		if tlsClientCalls.ContainsPkgCallExpr(node, c, false) != nil {
+			return config, clientTLSRole
+		}
+	case *ast.CompositeLit:
+		for _, elt := range node.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				if role := fieldRole(key.Name, node, c); role != noTLSRole {
+					return kv.Value, role
+				}
+			}
+		}
+	case *ast.AssignStmt:
+		for i, lhs := range node.Lhs {
+			if sel, ok := lhs.(*ast.SelectorExpr); ok && i < len(node.Rhs) {
+				if role := fieldRole(sel.Sel.Name, sel.X, c); role != noTLSRole {
+					return node.Rhs[i], role
+				}
+			}
+		}
+	}
+	return nil, noTLSRole
+}
+
+// role returns the TLS configuration variable used by the node and whether it is used
+// by a server or by a client
+func (r *sharedTLSConfig) role(n ast.Node, c *gosec.Context) (types.Object, tlsRole) {
+	config, role := tlsConfigUse(n, c)
+	if config == nil {
+		return nil, noTLSRole
+	}
+	return configVariable(config, c), role
+}
+
+func (r *sharedTLSConfig) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	config, role := r.role(n, c)
+	if config == nil || role != serverTLSRole {
+		return nil, nil
+	}
+	var first ast.Node
+	shared := false
+	ast.Inspect(c.Root, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+		if obj, role := r.role(node, c); obj == config {
+			switch {
+			case role == serverTLSRole && first == nil:
+				first = node
+			case role == clientTLSRole:
+				shared = true
+			}
+		}
+		return true
+	})
+	// the issue is reported once, on the first server using the configuration
+	if !shared || first != n {
+		return nil, nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewSharedTLSConfig detects a tls.Config variable which is used both by a server and by
+// a client, where the settings of one role leak into the other.
+func NewSharedTLSConfig(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	return &sharedTLSConfig{
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Low,
+			What:       "The same TLS configuration is used by a server and by a client",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil), (*ast.AssignStmt)(nil)}
+}
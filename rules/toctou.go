@@ -0,0 +1,80 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type fileTOCTOU struct {
+	issue.MetaData
+	uses gosec.CallList
+}
+
+func (r *fileTOCTOU) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *fileTOCTOU) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	check, matched := gosec.MatchCallByPackage(n, c, "os", "Stat", "Lstat")
+	if !matched || len(check.Args) != 1 {
+		return nil, nil
+	}
+	body := enclosingFunc(n, c)
+	if body == nil {
+		return nil, nil
+	}
+	var use *ast.CallExpr
+	ast.Inspect(body, func(node ast.Node) bool {
+		if use != nil {
+			return false
+		}
+		if call := r.uses.ContainsPkgCallExpr(node, c, false); call != nil && call.Pos() > check.End() &&
+			len(call.Args) > 0 && samePath(check.Args[0], call.Args[0], c) {
+			use = call
+		}
+		return use == nil
+	})
+	if use == nil {
+		return nil, nil
+	}
+	_, checkName, _ := gosec.GetCallInfo(check, c)
+	_, useName, _ := gosec.GetCallInfo(use, c)
+	what := fmt.Sprintf("%s: the path checked with os.%s is used again by os.%s at line %d, use the file handle instead",
+		r.What, checkName, useName, c.FileSet.Position(use.Pos()).Line)
+	return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+}
+
+// NewTOCTOU detects the paths checked with os.Stat or os.Lstat and then opened, modified or
+// removed by path in the same function, since the file may be replaced between the check and
+// the use.
+func NewTOCTOU(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	uses := gosec.NewCallList()
+	uses.AddAll("os", "Open", "OpenFile", "Create", "ReadFile", "WriteFile", "Remove", "RemoveAll", "Chmod", "Chown")
+
+	return &fileTOCTOU{
+		uses: uses,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Low,
+			What:       "Time-of-check to time-of-use race on a file path",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,69 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type trustedTypeConversion struct {
+	issue.MetaData
+	calls gosec.CallList
+}
+
+func (r *trustedTypeConversion) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *trustedTypeConversion) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call := r.calls.ContainsPkgCallExpr(n, c, false)
+	if call == nil || len(call.Args) != 1 {
+		return nil, nil
+	}
+	arg := call.Args[0]
+	if _, ok := constantString(arg, c); ok {
+		return nil, nil
+	}
+	if !isTainted(arg, c, nil) {
+		return nil, nil
+	}
+	_, name, err := gosec.GetCallInfo(call, c)
+	if err != nil {
+		return nil, nil
+	}
+	what := fmt.Sprintf("%s: template.%s disables the escaping of html/template", r.What, name)
+	return withTaintTrail(c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), arg, c, nil), nil
+}
+
+// NewTrustedTypeConversion detects user input converted to one of the html/template types,
+// such as template.HTML, which mark their content as safe, so that html/template does not
+// escape it anymore
+func NewTrustedTypeConversion(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("html/template", "CSS", "HTML", "HTMLAttr", "JS", "JSStr", "Srcset", "URL")
+	return &trustedTypeConversion{
+		calls: calls,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "User input converted to a trusted template type",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,100 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unsafeTypeAssertion struct {
+	issue.MetaData
+	decoders     gosec.CallList
+	decoderTypes map[string]bool
+	decoded      taintSpec
+}
+
+func (r *unsafeTypeAssertion) ID() string {
+	return r.MetaData.ID
+}
+
+// isDecodeCall checks if the call decodes data into its last argument, whose values are
+// then untrusted
+func (r *unsafeTypeAssertion) isDecodeCall(call *ast.CallExpr, c *gosec.Context) bool {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Decode" {
+		if t := c.Info.TypeOf(sel.X); t != nil && r.decoderTypes[t.String()] {
+			return true
+		}
+	}
+	return r.decoders.ContainsPkgCallExpr(call, c, false) != nil
+}
+
+// commaOk checks if the result of the type assertion is assigned along with the boolean
+// telling whether the assertion holds
+func commaOk(assert *ast.TypeAssertExpr, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(c.Root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			found = len(node.Lhs) == 2 && len(node.Rhs) == 1 && node.Rhs[0] == assert
+		case *ast.ValueSpec:
+			found = len(node.Names) == 2 && len(node.Values) == 1 && node.Values[0] == assert
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *unsafeTypeAssertion) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	assert, ok := n.(*ast.TypeAssertExpr)
+	// the type of the assertions of a type switch is nil
+	if !ok || assert.Type == nil {
+		return nil, nil
+	}
+	if r.decoded.isTainted(assert.X, c) && !commaOk(assert, c) {
+		return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewUnsafeTypeAssertion detects type assertions without the comma-ok form on values decoded
+// from untrusted data, which panic when the data does not have the expected shape.
+func NewUnsafeTypeAssertion(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	decoders := gosec.NewCallList()
+	decoders.Add("encoding/json", "Unmarshal")
+	decoderTypes := map[string]bool{"*encoding/json.Decoder": true}
+	for _, pkg := range yamlPackages {
+		decoders.Add(pkg, "Unmarshal")
+		decoderTypes["*"+pkg+".Decoder"] = true
+	}
+
+	rule := &unsafeTypeAssertion{
+		decoders:     decoders,
+		decoderTypes: decoderTypes,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Low,
+			Confidence: issue.Medium,
+			What:       "Type assertion on untrusted data without the comma-ok form can panic",
+		},
+	}
+	rule.decoded = taintSpec{fills: rule.isDecodeCall}
+	return rule, []ast.Node{(*ast.TypeAssertExpr)(nil)}
+}
@@ -0,0 +1,127 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unsafePointerArithmetic struct {
+	issue.MetaData
+	lengths gosec.CallList
+}
+
+func (r *unsafePointerArithmetic) ID() string {
+	return r.MetaData.ID
+}
+
+// isPointerAddress checks if the expression is the address of a pointer converted with
+// uintptr(unsafe.Pointer(x)), either in place or through a variable
+func isPointerAddress(expr ast.Expr, c *gosec.Context) bool {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		obj, ok := c.Info.ObjectOf(ident).(*types.Var)
+		if !ok {
+			return false
+		}
+		if expr = assignedValue(obj, c); expr == nil {
+			return false
+		}
+	}
+	conv, ok := expr.(*ast.CallExpr)
+	if !ok || len(conv.Args) != 1 {
+		return false
+	}
+	fun, ok := conv.Fun.(*ast.Ident)
+	if !ok || fun.Name != "uintptr" {
+		return false
+	}
+	if _, isType := c.Info.ObjectOf(fun).(*types.TypeName); !isType {
+		return false
+	}
+	_, matched := gosec.MatchCallByPackage(conv.Args[0], c, "unsafe", "Pointer")
+	return matched
+}
+
+// isBuiltinLength checks if the length is computed with the len or cap builtins, which
+// are bound to an existing value
+func isBuiltinLength(expr ast.Expr, c *gosec.Context) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, isBuiltin := c.Info.ObjectOf(fun).(*types.Builtin)
+	return isBuiltin && (fun.Name == "len" || fun.Name == "cap")
+}
+
+func (r *unsafePointerArithmetic) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.BinaryExpr:
+		if node.Op != token.ADD && node.Op != token.SUB {
+			return nil, nil
+		}
+		if isPointerAddress(node.X, c) || isPointerAddress(node.Y, c) {
+			return c.NewIssue(n, r.ID(), "Pointer arithmetic with uintptr(unsafe.Pointer(x))", r.Severity, issue.High), nil
+		}
+	case *ast.CallExpr:
+		call := r.lengths.ContainsPkgCallExpr(node, c, false)
+		if call == nil || len(call.Args) != 2 {
+			return nil, nil
+		}
+		length := call.Args[1]
+		if isConstant(length, c) {
+			return nil, nil
+		}
+		if isTainted(length, c, nil) {
+			return withTaintTrail(c.NewIssue(n, r.ID(), "Length of unsafe.Slice or unsafe.String derived from user input", r.Severity, issue.High), length, c, nil), nil
+		}
+		if !isBuiltinLength(length, c) {
+			return c.NewIssue(n, r.ID(), "Length of unsafe.Slice or unsafe.String is not checked", r.Severity, issue.Low), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewUnsafePointerArithmetic detects the dangerous uses of the unsafe package: the pointer
+// arithmetic through uintptr conversions and the unsafe.Slice or unsafe.String calls whose
+// length is not constant. Unlike G103, the other uses of the unsafe package are not reported.
+func NewUnsafePointerArithmetic(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	lengths := gosec.NewCallList()
+	lengths.AddAll("unsafe", "Slice", "String")
+	return &unsafePointerArithmetic{
+		lengths: lengths,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Unsafe pointer arithmetic or unchecked length",
+		},
+	}, []ast.Node{(*ast.BinaryExpr)(nil), (*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,244 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unverifiedDownload struct {
+	issue.MetaData
+	writes      gosec.CallList
+	copies      gosec.CallList
+	creates     gosec.CallList
+	commands    gosec.CallList
+	hashes      gosec.CallList
+	hashMethods gosec.CallList
+	compares    gosec.CallList
+	signatures  gosec.CallList
+}
+
+func (r *unverifiedDownload) ID() string {
+	return r.MetaData.ID
+}
+
+// isHTTPResponse checks if the type is an http.Response or a pointer to it
+func isHTTPResponse(t types.Type) bool {
+	return t != nil && (t.String() == "*net/http.Response" || t.String() == "net/http.Response")
+}
+
+// downloadedContent tracks the content read from the body of an HTTP response
+var downloadedContent = taintSpec{
+	source: func(expr ast.Expr, c *gosec.Context) bool {
+		sel, ok := expr.(*ast.SelectorExpr)
+		return ok && sel.Sel.Name == "Body" && isHTTPResponse(c.Info.TypeOf(sel.X))
+	},
+}
+
+// samePath checks if both expressions refer to the same file path, either through
+// the same variable or through the same constant
+func samePath(a, b ast.Expr, c *gosec.Context) bool {
+	if identA, ok := a.(*ast.Ident); ok {
+		if identB, ok := b.(*ast.Ident); ok {
+			if obj := c.Info.ObjectOf(identA); obj != nil && obj == c.Info.ObjectOf(identB) {
+				return true
+			}
+		}
+	}
+	pathA, okA := constantString(a, c)
+	pathB, okB := constantString(b, c)
+	return okA && okB && pathA == pathB
+}
+
+// createdPath returns the path of the file created or opened by the call assigned
+// to the variable passed as destination
+func (r *unverifiedDownload) createdPath(dst ast.Expr, c *gosec.Context) ast.Expr {
+	ident, ok := dst.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := c.Info.ObjectOf(ident)
+	if obj == nil {
+		return nil
+	}
+	value := assignedValue(obj, c)
+	if value == nil {
+		return nil
+	}
+	if call := r.creates.ContainsPkgCallExpr(value, c, false); call != nil && len(call.Args) > 0 {
+		return call.Args[0]
+	}
+	return nil
+}
+
+// verified checks if the function body computes a hash which is compared, or verifies
+// a signature, before the given position
+func (r *unverifiedDownload) verified(body *ast.BlockStmt, before token.Pos, c *gosec.Context) bool {
+	hashed, compared, signed := false, false, false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || n.Pos() >= before {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			switch {
+			case r.signatures.ContainsPkgCallExpr(node, c, false) != nil:
+				signed = true
+			case r.hashes.ContainsPkgCallExpr(node, c, false) != nil, r.hashMethods.ContainsCallExpr(node, c) != nil:
+				hashed = true
+			case r.compares.ContainsPkgCallExpr(node, c, false) != nil:
+				compared = true
+			}
+		case *ast.BinaryExpr:
+			if (node.Op == token.EQL || node.Op == token.NEQ) && !isNil(node.X, c) && !isNil(node.Y, c) {
+				compared = true
+			}
+		}
+		return true
+	})
+	return signed || hashed && compared
+}
+
+// isNil checks if the expression is the predeclared nil
+func isNil(expr ast.Expr, c *gosec.Context) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.Info.ObjectOf(ident).(*types.Nil)
+	return ok
+}
+
+func (r *unverifiedDownload) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	var body *ast.BlockStmt
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		body = fn.Body
+	case *ast.FuncLit:
+		body = fn.Body
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	var (
+		written  []ast.Expr
+		write    ast.Node
+		executed ast.Node
+	)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok || executed != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case r.writes.ContainsPkgCallExpr(call, c, false) != nil:
+			if len(call.Args) == 3 && downloadedContent.isTainted(call.Args[1], c) {
+				written = append(written, call.Args[0])
+				if write == nil {
+					write = call
+				}
+			}
+		case r.copies.ContainsPkgCallExpr(call, c, false) != nil:
+			if len(call.Args) >= 2 && downloadedContent.isTainted(call.Args[1], c) {
+				if path := r.createdPath(call.Args[0], c); path != nil {
+					written = append(written, path)
+				}
+				if write == nil {
+					write = call
+				}
+			}
+		case r.commands.ContainsPkgCallExpr(call, c, false) != nil:
+			for _, arg := range call.Args {
+				if downloadedContent.isTainted(arg, c) {
+					executed = call
+				}
+				for _, path := range written {
+					if samePath(arg, path, c) {
+						executed = call
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	switch {
+	case executed != nil && !r.verified(body, executed.Pos(), c):
+		return c.NewIssue(executed, r.ID(), "Downloaded content is executed without verifying its integrity", r.Severity, r.Confidence), nil
+	case write != nil && !r.verified(body, write.Pos(), c):
+		return c.NewIssue(write, r.ID(), "Downloaded content is written to a file without verifying its integrity", r.Severity, r.Confidence), nil
+	}
+	return nil, nil
+}
+
+// NewUnverifiedDownload detects content downloaded over HTTP which is written to a file or
+// executed without verifying a checksum or a signature first.
+func NewUnverifiedDownload(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	writes := gosec.NewCallList()
+	writes.Add("os", "WriteFile")
+	writes.Add("io/ioutil", "WriteFile")
+
+	copies := gosec.NewCallList()
+	copies.AddAll("io", "Copy", "CopyN", "CopyBuffer")
+
+	creates := gosec.NewCallList()
+	creates.AddAll("os", "Create", "OpenFile")
+
+	commands := gosec.NewCallList()
+	commands.AddAll("os/exec", "Command", "CommandContext")
+
+	hashes := gosec.NewCallList()
+	hashes.AddAll("crypto/sha256", "Sum256", "Sum224")
+	hashes.AddAll("crypto/sha512", "Sum512", "Sum384", "Sum512_224", "Sum512_256")
+
+	hashMethods := gosec.NewCallList()
+	hashMethods.Add("hash.Hash", "Sum")
+
+	compares := gosec.NewCallList()
+	compares.Add("bytes", "Equal")
+	compares.Add("crypto/subtle", "ConstantTimeCompare")
+	compares.Add("crypto/hmac", "Equal")
+
+	signatures := gosec.NewCallList()
+	signatures.Add("crypto/ed25519", "Verify")
+	signatures.AddAll("crypto/rsa", "VerifyPKCS1v15", "VerifyPSS")
+	signatures.AddAll("crypto/ecdsa", "Verify", "VerifyASN1")
+
+	return &unverifiedDownload{
+		writes:      writes,
+		copies:      copies,
+		creates:     creates,
+		commands:    commands,
+		hashes:      hashes,
+		hashMethods: hashMethods,
+		compares:    compares,
+		signatures:  signatures,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Low,
+			What:       "Downloaded content is used without verifying its integrity",
+		},
+	}, []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+}
@@ -0,0 +1,105 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// curveBits maps the elliptic curves to their size in bits
+var curveBits = map[string]int{
+	"P224":   224,
+	"P256":   256,
+	"P384":   384,
+	"P521":   521,
+	"X25519": 256,
+}
+
+// dhGroupBits maps the Diffie-Hellman groups of github.com/monnand/dhkx to the size of their modulus
+var dhGroupBits = map[int64]int{
+	0:  2048,
+	1:  768,
+	2:  1024,
+	14: 2048,
+}
+
+type weakCurve struct {
+	issue.MetaData
+	curves  gosec.CallList
+	groups  gosec.CallList
+	minimum string
+}
+
+func (r *weakCurve) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *weakCurve) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	if call := r.curves.ContainsPkgCallExpr(n, c, false); call != nil {
+		_, name, err := gosec.GetCallInfo(call, c)
+		if err != nil {
+			return nil, nil
+		}
+		if bits, ok := curveBits[name]; ok && bits < curveBits[r.minimum] {
+			what := fmt.Sprintf("Elliptic curve %s is weaker than the minimum curve %s", name, r.minimum)
+			return c.NewIssue(n, r.ID(), what, r.Severity, r.Confidence), nil
+		}
+		return nil, nil
+	}
+	if call := r.groups.ContainsPkgCallExpr(n, c, false); call != nil && len(call.Args) == 1 {
+		group, confidence, ok := constantValue(call.Args[0], c)
+		if !ok {
+			return nil, nil
+		}
+		if bits, ok := dhGroupBits[group]; ok && bits < 2048 {
+			what := fmt.Sprintf("Diffie-Hellman group %d has a %d bits modulus, below 2048 bits", group, bits)
+			return c.NewIssue(n, r.ID(), what, r.Severity, confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewWeakECCurve detects the elliptic curves weaker than the minimum curve, P256 by default,
+// which can be changed with the "minimum_curve" from the rule configuration. The
+// Diffie-Hellman groups with a modulus below 2048 bits are reported as well.
+func NewWeakECCurve(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	minimum := conf.RuleSettings(id).String("minimum_curve", "P256")
+	if _, ok := curveBits[minimum]; !ok {
+		minimum = "P256"
+	}
+
+	curves := gosec.NewCallList()
+	curves.AddAll("crypto/elliptic", "P224", "P256", "P384", "P521")
+	curves.AddAll("crypto/ecdh", "P256", "P384", "P521", "X25519")
+
+	groups := gosec.NewCallList()
+	groups.Add("github.com/monnand/dhkx", "GetGroup")
+
+	return &weakCurve{
+		curves:  curves,
+		groups:  groups,
+		minimum: minimum,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Weak elliptic curve or Diffie-Hellman group",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
@@ -0,0 +1,107 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+// weakHMACHash is a broken hash function which should not be used in an HMAC
+type weakHMACHash struct {
+	name     string
+	severity issue.Score
+}
+
+type weakHMAC struct {
+	issue.MetaData
+	hashes map[string]weakHMACHash
+}
+
+func (r *weakHMAC) ID() string {
+	return r.MetaData.ID
+}
+
+// hashPackage returns the package of the hash constructor passed to hmac.New, given either
+// as a function value such as md5.New or as a function literal returning md5.New()
+func hashPackage(expr ast.Expr, c *gosec.Context) string {
+	if lit, ok := expr.(*ast.FuncLit); ok {
+		if len(lit.Body.List) != 1 {
+			return ""
+		}
+		ret, ok := lit.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return ""
+		}
+		call, ok := ret.Results[0].(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+		expr = call.Fun
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "New" {
+		return ""
+	}
+	fn, ok := c.Info.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ""
+	}
+	return fn.Pkg().Path()
+}
+
+func (r *weakHMAC) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, matched := gosec.MatchCallByPackage(n, c, "crypto/hmac", "New")
+	if !matched || len(call.Args) != 2 {
+		return nil, nil
+	}
+	hash, ok := r.hashes[hashPackage(call.Args[0], c)]
+	if !ok {
+		return nil, nil
+	}
+	what := fmt.Sprintf("%s: HMAC-%s", r.What, hash.name)
+	return c.NewIssue(n, r.ID(), what, hash.severity, r.Confidence), nil
+}
+
+// NewWeakHMACHash detects HMACs computed with a broken hash function such as MD5 or SHA-1.
+// The hashes allowed by the policy can be listed in the "allowed" setting of the rule.
+func NewWeakHMACHash(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	hashes := map[string]weakHMACHash{
+		"crypto/md5":              {"MD5", issue.Medium},
+		"golang.org/x/crypto/md4": {"MD4", issue.Medium},
+		"crypto/sha1":             {"SHA1", issue.Low},
+	}
+	for _, allowed := range conf.RuleSettings(id).Strings("allowed", nil) {
+		for pkg, hash := range hashes {
+			if strings.EqualFold(hash.name, allowed) || pkg == allowed {
+				delete(hashes, pkg)
+			}
+		}
+	}
+	return &weakHMAC{
+		hashes: hashes,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "Use of a weak hash function in an HMAC",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
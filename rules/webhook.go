@@ -0,0 +1,93 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type unverifiedWebhook struct {
+	issue.MetaData
+	decoders  gosec.CallList
+	verifiers gosec.CallList
+}
+
+func (r *unverifiedWebhook) ID() string {
+	return r.MetaData.ID
+}
+
+// calls checks if the function body contains a call from the list
+func (r *unverifiedWebhook) calls(body *ast.BlockStmt, list gosec.CallList, c *gosec.Context) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if !found && list.ContainsPkgCallExpr(n, c, false) != nil {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func (r *unverifiedWebhook) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, nil
+	}
+	readsBody := false
+	for _, arg := range call.Args {
+		if requestBody(arg, c) != nil {
+			readsBody = true
+			break
+		}
+	}
+	if !readsBody {
+		return nil, nil
+	}
+	body := enclosingFunc(call, c)
+	if body == nil || !r.calls(body, r.decoders, c) || r.calls(body, r.verifiers, c) {
+		return nil, nil
+	}
+	return c.NewIssue(n, r.ID(), r.What, r.Severity, r.Confidence), nil
+}
+
+// NewUnverifiedWebhook detects the HTTP handlers which decode the request body without computing
+// an HMAC or comparing a signature in constant time in the same function, as webhook receivers
+// are expected to verify the signature of the payload before processing it.
+func NewUnverifiedWebhook(id string, _ gosec.Config) (gosec.Rule, []ast.Node) {
+	decoders := gosec.NewCallList()
+	decoders.AddAll("encoding/json", "NewDecoder", "Unmarshal")
+	decoders.AddAll("encoding/xml", "NewDecoder", "Unmarshal")
+	decoders.Add("encoding/gob", "NewDecoder")
+	for _, pkg := range yamlPackages {
+		decoders.AddAll(pkg, "NewDecoder", "Unmarshal")
+	}
+	verifiers := gosec.NewCallList()
+	verifiers.AddAll("crypto/hmac", "New", "Equal")
+	verifiers.Add("crypto/subtle", "ConstantTimeCompare")
+
+	return &unverifiedWebhook{
+		decoders:  decoders,
+		verifiers: verifiers,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Low,
+			What:       "Request body decoded without verifying its HMAC signature",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
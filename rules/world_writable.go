@@ -0,0 +1,83 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+type worldWritableFile struct {
+	issue.MetaData
+	mask int64
+	pkgs []string
+}
+
+func (r *worldWritableFile) ID() string {
+	return r.MetaData.ID
+}
+
+func (r *worldWritableFile) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	for _, pkg := range r.pkgs {
+		call, matched := gosec.MatchCallByPackage(n, c, pkg, "WriteFile")
+		if !matched || len(call.Args) != 3 {
+			continue
+		}
+		mode, confidence, ok := constantValue(call.Args[2], c)
+		if !ok {
+			continue
+		}
+		if bits := mode & r.mask; bits != 0 {
+			what := fmt.Sprintf("%s: permissions %#o grant %s", r.What, mode, permissionBits(bits))
+			return c.NewIssue(n, r.ID(), what, r.Severity, confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// permissionBits describes the given permission bits, e.g. "group write, other write"
+func permissionBits(bits int64) string {
+	var names []string
+	for i, class := range []string{"owner", "group", "other"} {
+		shift := 3 * (2 - i)
+		for j, access := range []string{"read", "write", "execute"} {
+			if bits&(1<<(shift+2-j)) != 0 {
+				names = append(names, class+" "+access)
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// NewWorldWritableIoutil detects files written with ioutil.WriteFile or os.WriteFile with
+// permissions which grant the group or the other users write access
+func NewWorldWritableIoutil(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	mask := int64(0o022)
+	mask = conf.RuleSettings(id).Int("mask", mask)
+	return &worldWritableFile{
+		mask: mask,
+		pkgs: []string{"io/ioutil", "os"},
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.High,
+			What:       "File written with group or world writable permissions",
+		},
+	}, []ast.Node{(*ast.CallExpr)(nil)}
+}
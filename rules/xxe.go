@@ -0,0 +1,108 @@
+// (c) Copyright gosec's authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+
+	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
+)
+
+const xmlPkg = "encoding/xml"
+
+type xxeCheck struct {
+	issue.MetaData
+	calls   gosec.CallList
+	trusted map[string]bool
+}
+
+func (r *xxeCheck) ID() string {
+	return r.MetaData.ID
+}
+
+// enablesEntities checks if the assignment relaxes the xml.Decoder so that it expands entities
+func enablesEntities(assign *ast.AssignStmt, c *gosec.Context) bool {
+	for i, lhs := range assign.Lhs {
+		sel, ok := lhs.(*ast.SelectorExpr)
+		if !ok || i >= len(assign.Rhs) {
+			continue
+		}
+		if t := c.Info.TypeOf(sel.X); t == nil || (t.String() != "*"+xmlPkg+".Decoder" && t.String() != xmlPkg+".Decoder") {
+			continue
+		}
+		value := c.Info.Types[assign.Rhs[i]]
+		switch sel.Sel.Name {
+		case "Strict":
+			if value.Value != nil && value.Value.Kind() == constant.Bool && !constant.BoolVal(value.Value) {
+				return true
+			}
+		case "Entity":
+			if !value.IsNil() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *xxeCheck) Match(n ast.Node, c *gosec.Context) (*issue.Issue, error) {
+	switch node := n.(type) {
+	case *ast.AssignStmt:
+		if r.trusted[xmlPkg] {
+			return nil, nil
+		}
+		if enablesEntities(node, c) {
+			return c.NewIssue(node, r.ID(), "xml.Decoder is configured to expand custom entities", r.Severity, issue.High), nil
+		}
+	case *ast.CallExpr:
+		if r.calls.ContainsPkgCallExpr(node, c, false) != nil {
+			return c.NewIssue(node, r.ID(), "XML parser which processes DTDs and external entities", r.Severity, r.Confidence), nil
+		}
+	}
+	return nil, nil
+}
+
+// NewXXECheck detects XML parsing which might resolve external entities, either through
+// libraries backed by libxml2 or through a relaxed xml.Decoder. Trusted packages can be
+// excluded from the check with the "trusted" list from the rule configuration.
+func NewXXECheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	calls := gosec.NewCallList()
+	calls.AddAll("github.com/lestrrat-go/libxml2", "Parse", "ParseString", "ParseReader")
+	calls.Add("github.com/lestrrat-go/libxml2/parser", "New")
+	calls.AddAll("github.com/moovweb/gokogiri", "ParseXml")
+	calls.AddAll("github.com/moovweb/gokogiri/xml", "Parse", "ReadFile")
+
+	trusted := map[string]bool{}
+	for _, path := range conf.RuleSettings(id).Strings("trusted", nil) {
+		trusted[path] = true
+	}
+
+	for pkg := range trusted {
+		delete(calls, pkg)
+	}
+
+	return &xxeCheck{
+		calls:   calls,
+		trusted: trusted,
+		MetaData: issue.MetaData{
+			ID:         id,
+			Severity:   issue.Medium,
+			Confidence: issue.Medium,
+			What:       "Potential XML external entity (XXE) processing",
+		},
+	}, []ast.Node{(*ast.AssignStmt)(nil), (*ast.CallExpr)(nil)}
+}
@@ -101,4 +101,78 @@ func main() {
 	defer l.Close()
 }
 `}, 1, gosec.NewConfig()},
+	// Bind the pprof endpoints to all networks
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+)
+
+func main() {
+	l, err := net.Listen("tcp", "0.0.0.0:6060")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Fatal(http.Serve(l, nil))
+}
+`}, 1, gosec.NewConfig()},
+	// Bind the pprof endpoints to the loopback interface
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+)
+
+func main() {
+	l, err := net.Listen("tcp", "127.0.0.1:6060")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Fatal(http.Serve(l, nil))
+}
+`}, 0, gosec.NewConfig()},
+	// Bind the pprof endpoints to the loopback interface when it is not considered safe
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+)
+
+func main() {
+	l, err := net.Listen("tcp", "127.0.0.1:6060")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Fatal(http.Serve(l, nil))
+}
+`}, 1, gosec.Config{"G102": map[string]interface{}{"loopback_safe": false}}},
+	// Bind a server to the loopback interface when it is not considered safe
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net"
+)
+
+func main() {
+	l, err := net.Listen("tcp", "127.0.0.1:2000")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer l.Close()
+}
+`}, 0, gosec.Config{"G102": map[string]interface{}{"loopback_safe": false}}},
 }
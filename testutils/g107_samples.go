@@ -193,4 +193,165 @@ func main() {
 	get(url)
 }
 `}, 1, gosec.NewConfig()},
+	{[]string{`
+// An address received in an HTTP request is not secure
+package main
+
+import (
+	"net"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	conn, err := net.Dial("tcp", r.URL.Query().Get("addr"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+)
+
+func main() {
+	addr := os.Getenv("TARGET") + ":443"
+	if _, err := net.DialTimeout("tcp", addr, time.Second); err != nil {
+		panic(err)
+	}
+	var d net.Dialer
+	if _, err := d.DialContext(context.Background(), "tcp", addr); err != nil {
+		panic(err)
+	}
+}
+`}, 2, gosec.NewConfig()},
+	{[]string{`
+// A URL parsed from user input is sent by a client
+package main
+
+import (
+	"net/http"
+	"net/url"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	target, err := url.Parse(r.FormValue("target"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req, err := http.NewRequest(http.MethodGet, target.String(), nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+)
+
+func main() {
+	target, err := url.Parse(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	client := &http.Client{}
+	resp, err := client.Do(&http.Request{Method: http.MethodGet, URL: target})
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// Constant hosts are safe
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+const host = "api.example.com:443"
+
+func main() {
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	var d net.Dialer
+	if _, err := d.DialContext(context.Background(), "tcp", "127.0.0.1:8080"); err != nil {
+		panic(err)
+	}
+	target, err := url.Parse("https://api.example.com/status")
+	if err != nil {
+		panic(err)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, target.String(), nil)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+// The context of the incoming request does not taint the URL
+package main
+
+import (
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, "https://api.example.com/status", nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
 }
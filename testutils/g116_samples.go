@@ -0,0 +1,246 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG116 - Insecure cookie
+var SampleCodeG116 = []CodeSample{
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	cookie := http.Cookie{
+		Name:  "session",
+		Value: "value",
+	}
+	http.SetCookie(w, &cookie)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: false,
+	})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	cookie := &http.Cookie{Name: "session", Value: "value"}
+	cookie.Secure = true
+	cookie.HttpOnly = true
+	http.SetCookie(w, cookie)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+	})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+	})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.Config{"G116": map[string]interface{}{"require_samesite": true}}},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.Config{"G116": map[string]interface{}{"require_samesite": true}}},
+	{[]string{`
+package main
+
+import (
+	"github.com/gorilla/sessions"
+)
+
+var store = sessions.NewCookieStore([]byte("key"))
+
+func main() {
+	store.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   3600,
+		Secure:   true,
+		HttpOnly: true,
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/sessions"
+)
+
+var store = sessions.NewCookieStore([]byte("key"))
+
+func main() {
+	store.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   3600,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/sessions"
+)
+
+var store = sessions.NewCookieStore([]byte("key"))
+
+func main() {
+	store.Options = &sessions.Options{
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/sessions"
+)
+
+var store = sessions.NewCookieStore([]byte("key"))
+
+func main() {
+	store.Options = &sessions.Options{
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	cookie := http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteDefaultMode,
+	}
+	http.SetCookie(w, &cookie)
+}
+`}, 1, gosec.Config{"G116": map[string]interface{}{"require_samesite": true}}},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	cookie := http.Cookie{
+		Name:     "session",
+		Value:    "value",
+		Secure:   true,
+		HttpOnly: true,
+	}
+	cookie.SameSite = http.SameSiteLaxMode
+	http.SetCookie(w, &cookie)
+}
+`}, 0, gosec.Config{"G116": map[string]interface{}{"require_samesite": true}}},
+}
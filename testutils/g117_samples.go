@@ -0,0 +1,106 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG117 - Missing http.Server timeouts
+var SampleCodeG117 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"time"
+)
+
+func newServer() *http.Server {
+	return &http.Server{
+		Addr:              ":8080",
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+}
+
+func main() {
+	srv := newServer()
+	if err := srv.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"time"
+)
+
+func newServer() *http.Server {
+	return &http.Server{
+		Addr:              ":8080",
+		ReadHeaderTimeout: 3 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+}
+
+func main() {
+	srv := newServer()
+	if err := srv.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"time"
+)
+
+func main() {
+	srv := &http.Server{
+		Addr:              ":8080",
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	srv.ReadTimeout = 5 * time.Second
+	if err := srv.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func main() {
+	srv := &http.Server{Addr: ":8080"}
+	if err := srv.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"time"
+)
+
+func newServer() *http.Server {
+	return &http.Server{
+		Addr:              ":8080",
+		ReadHeaderTimeout: 3 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+}
+
+func main() {
+	srv := newServer()
+	if err := srv.ListenAndServe(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.Config{"G117": map[string]interface{}{"timeouts": []interface{}{"IdleTimeout"}}}},
+}
@@ -0,0 +1,118 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG118 - Permissive CORS policy
+var SampleCodeG118 = []CodeSample{
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.WriteHeader(http.StatusOK)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.Config{"G118": map[string]interface{}{"report_wildcard": true}}},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "https://example.com")
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.WriteHeader(http.StatusOK)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.Config{"G118": map[string]interface{}{"report_wildcard": true}}},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/rs/cors"
+)
+
+func main() {
+	c := cors.New(cors.Options{
+		AllowedOrigins:   []string{"*"},
+		AllowCredentials: true,
+	})
+	handler := c.Handler(http.DefaultServeMux)
+	http.ListenAndServe(":8080", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/rs/cors"
+)
+
+func main() {
+	c := cors.New(cors.Options{
+		AllowedOrigins:   []string{"https://example.com"},
+		AllowCredentials: true,
+	})
+	handler := c.Handler(http.DefaultServeMux)
+	http.ListenAndServe(":8080", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+
+	"github.com/rs/cors"
+)
+
+func main() {
+	c := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+	})
+	http.ListenAndServe(":8080", c.Handler(http.DefaultServeMux))
+	http.ListenAndServe(":8081", cors.AllowAll().Handler(http.DefaultServeMux))
+}
+`}, 2, gosec.Config{"G118": map[string]interface{}{"report_wildcard": true}}},
+}
@@ -0,0 +1,107 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG119 - Regular expression denial of service
+var SampleCodeG119 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	pattern := r.URL.Query().Get("pattern")
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Fprint(w, re.MatchString("value"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+func main() {
+	re := regexp.MustCompile("^" + os.Args[1] + "$")
+	fmt.Println(re.MatchString("value"))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	re := regexp.MustCompile("^" + regexp.QuoteMeta(r.FormValue("prefix")))
+	fmt.Fprint(w, re.MatchString("value"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var validID = regexp.MustCompile("^[a-z]+\\[[0-9]+\\]$")
+
+func main() {
+	fmt.Println(validID.MatchString("adam[23]"))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const pattern = "^(a+)+$"
+
+func main() {
+	re := regexp.MustCompile(pattern)
+	fmt.Println(re.MatchString("aaaa"))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const pattern = "^(a+)+$"
+
+func main() {
+	re := regexp.MustCompile(pattern)
+	fmt.Println(re.MatchString("aaaa"))
+	fmt.Println(regexp.MustCompile("^[a-z]+[0-9]*$").MatchString("abc1"))
+}
+`}, 1, gosec.Config{"G119": map[string]interface{}{"static_patterns": true}}},
+}
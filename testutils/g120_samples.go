@@ -0,0 +1,108 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG120 - Sensitive data written to the logs
+var SampleCodeG120 = []CodeSample{
+	{[]string{`
+package main
+
+import "log"
+
+func login(username, password string) {
+	log.Printf("login attempt for %s with password %s", username, password)
+}
+
+func main() {
+	login("admin", "admin")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type config struct {
+	User     string
+	APIToken string
+}
+
+func main() {
+	cfg := config{User: "admin", APIToken: os.Args[1]}
+	fmt.Fprintln(os.Stderr, "using token", cfg.APIToken)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type config struct {
+	User     string
+	APIToken string
+}
+
+func main() {
+	cfg := config{User: "admin", APIToken: os.Args[1]}
+	fmt.Fprintln(os.Stderr, "using token", cfg.APIToken)
+}
+`}, 1, gosec.Config{gosec.Globals: map[gosec.GlobalOption]string{gosec.Audit: "enabled"}}},
+	{[]string{`
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	key := os.Getenv("DB_PASSWORD")
+	logger := log.New(os.Stdout, "", log.LstdFlags)
+	logger.Println("connecting with", key)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"log"
+)
+
+func login(username, password string) {
+	log.Printf("login attempt for %s (password length %d)", username, len(password))
+}
+
+func main() {
+	login("admin", "admin")
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	home := os.Getenv("HOME")
+	log.Println("home directory", home)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "log"
+
+func main() {
+	ssn := "078-05-1120"
+	log.Println(ssn)
+}
+`}, 1, gosec.Config{"G120": map[string]interface{}{"pattern": "(?i)ssn"}}},
+}
@@ -0,0 +1,232 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG122 - Open redirect
+var SampleCodeG122 = []CodeSample{
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.URL.Query().Get("next")
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Location", r.Header.Get("Referer"))
+	w.WriteHeader(http.StatusSeeOther)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "/home", http.StatusFound)
+}
+
+func profile(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "/profile/"+r.FormValue("user"), http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+	http.HandleFunc("/profile", profile)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+var allowed = map[string]bool{
+	"/home":     true,
+	"/settings": true,
+}
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	if !allowed[next] {
+		next = "/home"
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.URL.Query().Get("next")
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = "/"
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.URL.Query().Get("next")
+	if strings.HasPrefix(next, "/") {
+		http.Redirect(w, r, next, http.StatusFound)
+	}
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func isAllowedRedirect(location string) bool {
+	return location == "/home"
+}
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.URL.Query().Get("next")
+	if !isAllowedRedirect(next) {
+		http.Error(w, "invalid redirect", http.StatusBadRequest)
+		return
+	}
+	w.Header().Add("location", next)
+	w.WriteHeader(http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	if next == "" {
+		next = "/"
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	if !strings.HasPrefix(next, "https://") {
+		next = "/"
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	switch next {
+	case "":
+		next = "/"
+	default:
+		http.Redirect(w, r, next, http.StatusFound)
+	}
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	if !strings.HasPrefix(next, "https://example.com/") {
+		next = "/"
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	switch next {
+	case "/home", "/settings":
+		http.Redirect(w, r, next, http.StatusFound)
+	default:
+		http.Error(w, "invalid redirect", http.StatusBadRequest)
+	}
+}
+
+func main() {
+	http.HandleFunc("/login", login)
+}
+`}, 0, gosec.NewConfig()},
+}
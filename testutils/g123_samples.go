@@ -0,0 +1,130 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG123 - Unclosed HTTP response body
+var SampleCodeG123 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func main() {
+	resp, err := http.Get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(resp.StatusCode)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+func main() {
+	resp, err := http.Get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(body))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected status")
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	return body, err
+}
+
+func main() {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	fetch(http.DefaultClient, req)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"io"
+	"net/http"
+)
+
+func fetch(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	return body, err
+}
+
+func main() {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	fetch(http.DefaultClient, req)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func get(url string) (*http.Response, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func main() {
+	resp, err := get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func main() {
+	_, err := http.Post("https://example.com", "text/plain", nil)
+	if err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+}
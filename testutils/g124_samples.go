@@ -0,0 +1,92 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG124 - HTTP handler without security headers
+var SampleCodeG124 = []CodeSample{
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Strict-Transport-Security", "max-age=63072000; includeSubDomains")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Write([]byte("hello"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("hello"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func main() {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	})
+}
+`}, 1, gosec.Config{gosec.Globals: map[gosec.GlobalOption]string{gosec.IncludeRules: "G101,G124"}}},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	h.Set("strict-transport-security", "max-age=3600")
+	h.Set("X-Content-Type-Options", "nosniff")
+	h.Set("X-Frame-Options", "DENY")
+	w.Write([]byte("hello"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Security-Policy", "default-src 'self'")
+	w.Write([]byte("hello"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.Config{"G124": map[string]interface{}{"headers": []interface{}{"content-security-policy"}}}},
+	{[]string{`
+package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Write([]byte("hello"))
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.Config{"G124": map[string]interface{}{"headers": []interface{}{"Content-Security-Policy", "X-Content-Type-Options"}}}},
+}
@@ -0,0 +1,123 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG127 - Downloaded content used without an integrity check
+var SampleCodeG127 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+)
+
+func main() {
+	resp, err := http.Get("https://example.com/tool")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile("/tmp/tool", data, 0o700); err != nil {
+		panic(err)
+	}
+	if err := exec.Command("/tmp/tool").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+)
+
+const expectedSum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func main() {
+	resp, err := http.Get("https://example.com/tool")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != expectedSum {
+		panic("checksum mismatch")
+	}
+	if err := os.WriteFile("/tmp/tool", data, 0o700); err != nil {
+		panic(err)
+	}
+	if err := exec.Command("/tmp/tool").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"io"
+	"net/http"
+	"os"
+)
+
+func download(url, path string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+func main() {
+	if err := download("https://example.com/archive.tar.gz", "/tmp/archive.tar.gz"); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+func main() {
+	resp, err := http.Get("https://example.com/data")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+}
+`}, 0, gosec.NewConfig()},
+}
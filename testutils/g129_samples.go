@@ -0,0 +1,129 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG129 - Type assertion without the comma-ok form on untrusted data
+var SampleCodeG129 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var payload interface{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fields := payload.(map[string]interface{})
+	fmt.Fprintln(w, fields)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var payload interface{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fields, ok := payload.(map[string]interface{})
+	if !ok {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	name, ok := fields["name"].(string)
+	if !ok {
+		http.Error(w, "invalid name", http.StatusBadRequest)
+		return
+	}
+	fmt.Fprintln(w, name)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func parse(data []byte) (string, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	name := fields["name"]
+	return name.(string), nil
+}
+
+func main() {
+	name, err := parse([]byte("{}"))
+	fmt.Println(name, err)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func describe(data []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func main() {
+	var value interface{} = "local"
+	fmt.Println(describe([]byte("{}")), value.(string))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+func main() {
+	var items []interface{}
+	if err := json.Unmarshal([]byte(os.Args[1]), &items); err != nil {
+		panic(err)
+	}
+	for _, item := range items {
+		fmt.Println(item.(string))
+	}
+}
+`}, 1, gosec.NewConfig()},
+}
@@ -0,0 +1,95 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG130 - Secret environment variables passed to a subprocess or printed
+var SampleCodeG130 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	password := os.Getenv("DB_PASSWORD")
+	cmd := exec.Command("backup", "--all")
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main() {
+	password := os.Getenv("DB_PASSWORD")
+	db, err := sql.Open("postgres", "user=app password="+password)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	fmt.Println("connected to", os.Getenv("DB_HOST"))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Printf("token: %s\n", os.Getenv("API_TOKEN"))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	cmd := &exec.Cmd{
+		Path: "/usr/bin/convert",
+		Args: []string{"convert", r.URL.Query().Get("file")},
+		Env:  []string{"API_SECRET=" + os.Getenv("API_SECRET")},
+	}
+	if err := cmd.Run(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("backup", "--all")
+	cmd.Env = append(os.Environ(), "PGHOST="+os.Getenv("DB_HOST"))
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
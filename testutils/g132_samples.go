@@ -0,0 +1,100 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG132 - Goroutines which can panic without a deferred recover
+var SampleCodeG132 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	go func() {
+		fmt.Println("processing", parts[2])
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Printf("recovered: %v", err)
+			}
+		}()
+		fmt.Println("processing", parts[2])
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+func main() {
+	done := make(chan bool)
+	go func() {
+		done <- true
+	}()
+	<-done
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+func handlePanic() {
+	if err := recover(); err != nil {
+		log.Printf("recovered: %v", err)
+	}
+}
+
+func worker(jobs <-chan interface{}) {
+	defer handlePanic()
+	for job := range jobs {
+		fmt.Println(job.(string))
+	}
+}
+
+func unsafeWorker(jobs <-chan interface{}) {
+	for job := range jobs {
+		fmt.Println(job.(string))
+	}
+}
+
+func main() {
+	jobs := make(chan interface{})
+	go worker(jobs)
+	go unsafeWorker(jobs)
+	close(jobs)
+}
+`}, 1, gosec.NewConfig()},
+}
@@ -0,0 +1,71 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG133 - gRPC servers exposing the reflection or debug services
+var SampleCodeG133 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	lis, err := net.Listen("tcp", ":50051")
+	if err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer()
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	channelz "google.golang.org/grpc/channelz/service"
+)
+
+func main() {
+	lis, err := net.Listen("tcp", ":50051")
+	if err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer()
+	channelz.RegisterChannelzServiceToServer(s)
+	if err := s.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+func main() {
+	lis, err := net.Listen("tcp", ":50051")
+	if err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer()
+	if err := s.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
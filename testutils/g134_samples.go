@@ -0,0 +1,74 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG134 - Webhook handlers decoding the request body without verifying its signature
+var SampleCodeG134 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type event struct {
+	Action string
+}
+
+func webhook(w http.ResponseWriter, r *http.Request) {
+	var e event
+	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func main() {
+	http.HandleFunc("/webhook", webhook)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+)
+
+type event struct {
+	Action string
+}
+
+func webhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	mac := hmac.New(sha256.New, []byte(os.Getenv("WEBHOOK_SECRET")))
+	mac.Write(payload)
+	signature, err := hex.DecodeString(r.Header.Get("X-Signature"))
+	if err != nil || !hmac.Equal(mac.Sum(nil), signature) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func main() {
+	http.HandleFunc("/webhook", webhook)
+}
+`}, 0, gosec.NewConfig()},
+}
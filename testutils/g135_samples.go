@@ -0,0 +1,45 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG135 - Subprocesses launched with a binary name relative to $PATH
+var SampleCodeG135 = []CodeSample{
+	{[]string{`
+package main
+
+import "os/exec"
+
+func main() {
+	if err := exec.Command("ls", "-l").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"context"
+	"os/exec"
+)
+
+const git = "git"
+
+func main() {
+	if err := exec.CommandContext(context.Background(), git, "status").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os/exec"
+
+func main() {
+	if err := exec.Command("/bin/ls", "-l").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
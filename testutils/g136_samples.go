@@ -0,0 +1,54 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG136 - Disabled Go module verification
+var SampleCodeG136 = []CodeSample{
+	{[]string{`
+package main
+
+import "os/exec"
+
+func main() {
+	if err := exec.Command("go", "get", "-insecure", "example.com/module").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("go", "mod", "download")
+	cmd.Env = append(os.Environ(), "GOPROXY=off", "GOSUMDB=off")
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+const script = "export GOFLAGS='-mod=mod -insecure'\ngo build ./..."
+
+func main() {
+	println(script)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os/exec"
+
+func main() {
+	if err := exec.Command("go", "get", "example.com/module").Run(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,57 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG137 - Method selected by reflection from user input
+var SampleCodeG137 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"reflect"
+)
+
+type api struct{}
+
+func (api) Status(w http.ResponseWriter) {
+	_, _ = w.Write([]byte("ok"))
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	method := reflect.ValueOf(api{}).MethodByName(r.URL.Query().Get("action"))
+	if method.IsValid() {
+		method.Call([]reflect.Value{reflect.ValueOf(w)})
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"reflect"
+)
+
+type api struct{}
+
+func (api) Status(w http.ResponseWriter) {
+	_, _ = w.Write([]byte("ok"))
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	method := reflect.ValueOf(api{}).MethodByName("Status")
+	method.Call([]reflect.Value{reflect.ValueOf(w)})
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,88 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG138 - Request body decoded without DisallowUnknownFields
+var SampleCodeG138 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type transfer struct {
+	From   string
+	To     string
+	Amount float64
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var t transfer
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type transfer struct {
+	From   string
+	To     string
+	Amount float64
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var t transfer
+	dec := json.NewDecoder(r.Body)
+	if err := dec.Decode(&t); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type transfer struct {
+	From   string
+	To     string
+	Amount float64
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var t transfer
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&t); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,61 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG139 - Request object written to the logs
+var SampleCodeG139 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("request headers: %v", r.Header)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("request received", "request", r)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"log"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("request: %s %s", r.Method, r.URL.Path)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -397,5 +397,74 @@ func main() {
 	}
 	defer stmt.Close()
 }
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+// Format string inline in the query call
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	rows, err := db.QueryContext(context.Background(), fmt.Sprintf("SELECT * FROM foo WHERE name = '%s'", os.Args[1]))
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// Format string in a variable passed to the query call with a context
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo WHERE name = '%s'", os.Args[1])
+	rows, err := db.QueryContext(context.Background(), q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// Parameterized query
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+)
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	rows, err := db.QueryContext(context.Background(), "SELECT * FROM foo WHERE name = ?", os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}
 `}, 0, gosec.NewConfig()},
 }
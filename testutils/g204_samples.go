@@ -241,5 +241,47 @@ func main() {
 	err = cmd.Wait()
 	log.Printf("Command finished with error: %v", err)
 }
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// The script run through a shell interpreter is reported by G206
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("sh", "-c", os.Args[1])
+	_ = cmd.Run()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+// The script run through a shell interpreter is reported when G206 is excluded
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("sh", "-c", os.Args[1])
+	_ = cmd.Run()
+}
+`}, 1, gosec.Config{gosec.Globals: map[gosec.GlobalOption]string{gosec.ExcludeRules: "G206"}}},
+	{[]string{`
+// The arguments passed to the script are still reported
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("sh", "-c", "echo $1", "sh", os.Args[1])
+	_ = cmd.Run()
+}
 `}, 1, gosec.NewConfig()},
 }
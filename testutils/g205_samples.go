@@ -0,0 +1,95 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG205 - XML external entity processing
+var SampleCodeG205 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+func main() {
+	decoder := xml.NewDecoder(strings.NewReader("<doc>&custom;</doc>"))
+	decoder.Strict = false
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		fmt.Println(tok)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+type doc struct {
+	Body string ` + "`xml:\"body\"`" + `
+}
+
+func main() {
+	decoder := xml.NewDecoder(strings.NewReader("<doc><body>&custom;</body></doc>"))
+	decoder.Entity = map[string]string{"custom": "value"}
+	var d doc
+	if err := decoder.Decode(&d); err != nil {
+		panic(err)
+	}
+	fmt.Println(d.Body)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+type doc struct {
+	Body string ` + "`xml:\"body\"`" + `
+}
+
+func main() {
+	decoder := xml.NewDecoder(strings.NewReader("<doc><body>text</body></doc>"))
+	decoder.Strict = true
+	decoder.Entity = nil
+	var d doc
+	if err := decoder.Decode(&d); err != nil {
+		panic(err)
+	}
+	fmt.Println(d.Body)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+func main() {
+	decoder := xml.NewDecoder(strings.NewReader("<doc>&nbsp;</doc>"))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	tok, err := decoder.Token()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(tok)
+}
+`}, 0, gosec.Config{"G205": map[string]interface{}{"trusted": []interface{}{"encoding/xml"}}}},
+}
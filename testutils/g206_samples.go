@@ -0,0 +1,116 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG206 - Shell interpreter launched with a script
+var SampleCodeG206 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	script := os.Args[1]
+	cmd := exec.Command("sh", "-c", script)
+	_ = cmd.Run()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"context"
+	"os/exec"
+)
+
+func run(ctx context.Context, name string) error {
+	return exec.CommandContext(ctx, "/bin/bash", "-c", "echo "+name).Run()
+}
+
+func main() {
+	_ = run(context.Background(), "test")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os/exec"
+)
+
+func run(script string) error {
+	return exec.Command("cmd.exe", "/C", script).Run()
+}
+
+func main() {
+	_ = run("dir")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os/exec"
+)
+
+func run(script string) error {
+	return exec.Command("powershell", "-NoProfile", "-Command", script).Run()
+}
+
+func main() {
+	_ = run("Get-ChildItem")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("sh", "-c", "ls -la | grep go")
+	_ = cmd.Run()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("ls")
+	_ = cmd.Run()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("ls", "-c", os.Args[1])
+	_ = cmd.Run()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os/exec"
+)
+
+func main() {
+	cmd := exec.Command("sh", "script.sh")
+	_ = cmd.Run()
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,129 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG207 - Untrusted data decoded into an interface
+var SampleCodeG207 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"encoding/gob"
+	"fmt"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var payload interface{}
+	if err := gob.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Fprint(w, payload)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+)
+
+func main() {
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	dec := gob.NewDecoder(f)
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		panic(err)
+	}
+	fmt.Println(value)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var config interface{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Fprint(w, config)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/gob"
+	"fmt"
+	"net/http"
+)
+
+type Message struct {
+	ID   int
+	Text string
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	var msg Message
+	if err := gob.NewDecoder(r.Body).Decode(&msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	fmt.Fprint(w, msg.Text)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+func main() {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode("value"); err != nil {
+		panic(err)
+	}
+	var value interface{}
+	if err := gob.NewDecoder(&buf).Decode(&value); err != nil {
+		panic(err)
+	}
+	fmt.Println(value)
+}
+`}, 0, gosec.NewConfig()},
+}
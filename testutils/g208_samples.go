@@ -0,0 +1,83 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG208 - Format string derived from user input
+var SampleCodeG208 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	userInput := r.URL.Query().Get("name")
+	fmt.Printf(userInput)
+	fmt.Fprintln(w, "ok")
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func validate(name string) error {
+	if name == "" {
+		return fmt.Errorf("empty name")
+	}
+	return nil
+}
+
+func main() {
+	message := "invalid input: " + os.Getenv("INPUT")
+	err := fmt.Errorf(message)
+	fmt.Println(err, validate(""))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+const greeting = "hello %s\n"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	userInput := r.URL.Query().Get("name")
+	fmt.Fprintf(w, greeting, userInput)
+	log.Printf("request from %s", userInput)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	format := "%d files\n"
+	if len(os.Args) > 2 {
+		format = "%d files found\n"
+	}
+	fmt.Printf(format, 3)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,71 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG209 - User input converted to a trusted html/template type
+var SampleCodeG209 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"html/template"
+	"net/http"
+)
+
+var page = template.Must(template.New("page").Parse("<p>{{.}}</p>"))
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	userInput := r.URL.Query().Get("bio")
+	if err := page.Execute(w, template.HTML(userInput)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"html/template"
+	"net/http"
+)
+
+var page = template.Must(template.New("page").Parse("<p>{{.}}</p>"))
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	callback := template.JS(r.FormValue("callback") + "()")
+	if err := page.Execute(w, callback); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"html/template"
+	"net/http"
+)
+
+const banner = "<em>welcome</em>"
+
+var page = template.Must(template.New("page").Parse("<p>{{.}}</p>"))
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	if err := page.Execute(w, []template.HTML{template.HTML("<b>ok</b>"), template.HTML(banner)}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,64 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG210 - User input written into a response header
+var SampleCodeG210 = []CodeSample{
+	{[]string{`
+package main
+
+import "net/http"
+
+func download(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", r.URL.Query().Get("ct"))
+	w.Write([]byte("content"))
+}
+
+func main() {
+	http.HandleFunc("/download", download)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func download(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("name")
+	w.Header().Add("Content-Disposition", "attachment; filename="+name)
+	w.Write([]byte("content"))
+}
+
+func main() {
+	http.HandleFunc("/download", download)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func download(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write([]byte("content"))
+}
+
+func main() {
+	http.HandleFunc("/download", download)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "net/http"
+
+func redirect(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Location", r.URL.Query().Get("next"))
+	w.WriteHeader(http.StatusFound)
+}
+
+func main() {
+	http.HandleFunc("/redirect", redirect)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -52,4 +52,150 @@ func main() {
 	}
 }
 `}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	perm := os.FileMode(0777)
+	err := os.MkdirAll("/tmp/mydir", perm)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const dirPerm = 0777
+
+func main() {
+	err := os.MkdirAll("/tmp/mydir", dirPerm)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	var perm os.FileMode = 0700
+	err := os.MkdirAll("/tmp/mydir", perm)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	perm := os.FileMode(0700)
+	if len(os.Args) > 1 {
+		perm |= 0o077
+	}
+	err := os.MkdirAll("/tmp/mydir", perm|os.ModeDir)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+func main() {
+	mode, _ := strconv.ParseUint(os.Getenv("DIR_MODE"), 8, 32)
+	perm := os.FileMode(mode)
+	err := os.MkdirAll("/tmp/mydir", perm)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	err := os.MkdirAll("/tmp/mydir", 0755)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 0, gosec.Config{"G301": "0755"}},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const worldWritable = 0777
+
+func main() {
+	err := os.MkdirAll("/tmp/mydir", worldWritable)
+	if err != nil {
+		fmt.Println("Error when creating a directory!")
+		return
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	dir := filepath.Join("/srv/uploads", r.URL.Query().Get("user"))
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+	_ = http.ListenAndServe(":8080", nil)
+}
+`}, 1, gosec.NewConfig()},
 }
@@ -2,7 +2,7 @@ package testutils
 
 import "github.com/securego/gosec/v2"
 
-// SampleCodeG305 - File path traversal when extracting zip/tar archives
+// SampleCodeG305 - File path traversal when extracting zip archives
 var SampleCodeG305 = []CodeSample{
 	{[]string{`
 package unzip
@@ -140,37 +140,41 @@ func extractFile(f *zip.File, destPath string) error {
 }
 `}, 1, gosec.NewConfig()},
 	{[]string{`
-package tz
+package untar
 
 import (
-    "archive/tar"
-    "io"
-    "os"
-    "path"
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
-func extractFile(f *tar.Header, tr *tar.Reader, destPath string) error {
-    filePath := path.Join(destPath, f.Name)
-    os.MkdirAll(path.Dir(filePath), os.ModePerm)
-
-    fw, err := os.Create(filePath)
-    if err != nil {
-        return err
-    }
-    defer fw.Close()
-
-    if _, err = io.Copy(fw, tr); err != nil {
-        return err
-    }
-
-    if f.FileInfo().Mode()&os.ModeSymlink != 0 {
-        return nil
-    }
-
-    if err = os.Chtimes(filePath, f.FileInfo().ModTime(), f.FileInfo().ModTime()); err != nil {
-        return err
-    }
-    return os.Chmod(filePath, f.FileInfo().Mode())
+func untar(r io.Reader, dest string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, header.Name)
+		if !strings.HasPrefix(filepath.Clean(target), dest) {
+			return fmt.Errorf("invalid file path: %s", header.Name)
+		}
+		f, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		if _, err := io.CopyN(f, tr, header.Size); err != nil {
+			f.Close()
+			return err
+		}
+		f.Close()
+	}
 }
-`}, 1, gosec.NewConfig()},
+`}, 0, gosec.NewConfig()},
 }
@@ -0,0 +1,133 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG309 - Temporary file created in a user-controlled or shared directory
+var SampleCodeG309 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"net/http"
+	"os"
+)
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	dir := r.URL.Query().Get("dir")
+	f, err := os.CreateTemp(dir, "upload-*")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	f, err := ioutil.TempFile(os.Getenv("CACHE_DIR"), "cache")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	f, err := os.CreateTemp("/tmp", "report-*.txt")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+const sharedDir = "/dev/shm/app"
+
+func main() {
+	f, err := ioutil.TempFile(sharedDir, "")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	f, err := os.CreateTemp("", "report-*.txt")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir, err := os.MkdirTemp("", "app")
+	if err != nil {
+		panic(err)
+	}
+	f, err := os.CreateTemp(filepath.Join(dir, "cache"), "")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	f, err := os.CreateTemp("/var/lib/app", "state")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f.Name())
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,134 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG310 - Deferred Close discards the error of a file opened for writing
+var SampleCodeG310 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	f, err := os.Create("output.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString("data"); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	f, err := os.OpenFile("output.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString("data"); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+)
+
+func compress(out *os.File) error {
+	var w io.WriteCloser = gzip.NewWriter(out)
+	defer w.Close()
+	_, err := w.Write([]byte("data"))
+	return err
+}
+
+func main() {
+	_ = compress(os.Stdout)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	f, err := os.Open("input.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	f, err := os.OpenFile("input.txt", os.O_RDONLY, 0)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+)
+
+func write() (err error) {
+	f, err := os.Create("output.txt")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+	_, err = f.WriteString("data")
+	return err
+}
+
+func main() {
+	if err := write(); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
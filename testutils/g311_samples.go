@@ -0,0 +1,97 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG311 - File written with group or world writable permissions
+var SampleCodeG311 = []CodeSample{
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), 0o644); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "io/ioutil"
+
+func main() {
+	if err := ioutil.WriteFile("/tmp/config", []byte("data"), 0666); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), 0o600); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+const perm = 0o666
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), perm); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), 0o664); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.Config{"G311": map[string]interface{}{"mask": "0002"}}},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), 0o644); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.Config{"G311": map[string]interface{}{"mask": "0044"}}},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), 0o666); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	if err := os.WriteFile("/tmp/config", []byte("data"), os.ModePerm); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+}
@@ -0,0 +1,93 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG312 - Secrets written to files readable by other users
+var SampleCodeG312 = []CodeSample{
+	{[]string{`
+package main
+
+import "os"
+
+func save(privateKey []byte) error {
+	return os.WriteFile("id_rsa", privateKey, 0o644)
+}
+
+func main() {
+	if err := save([]byte("key")); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"encoding/pem"
+	"os"
+)
+
+func save(privateKey []byte) error {
+	f, err := os.OpenFile("id_rsa", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: privateKey})
+}
+
+func main() {
+	if err := save([]byte("key")); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func save(privateKey []byte) error {
+	return os.WriteFile("id_rsa", privateKey, 0o600)
+}
+
+func main() {
+	if err := save([]byte("key")); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func save(privateKey []byte) error {
+	f, err := os.OpenFile("id_rsa", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(privateKey)
+	return err
+}
+
+func main() {
+	if err := save([]byte("key")); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import "os"
+
+func main() {
+	report := []byte("done")
+	if err := os.WriteFile("report.txt", report, 0o644); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,95 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG313 - Time-of-check to time-of-use race on a file path
+var SampleCodeG313 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func read(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func main() {
+	data, _ := read("/tmp/data")
+	fmt.Println(len(data))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	if _, err := os.Lstat("/tmp/lock"); err == nil {
+		_ = os.Remove("/tmp/lock")
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func read(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	return io.ReadAll(f)
+}
+
+func main() {
+	data, _ := read("/tmp/data")
+	fmt.Println(len(data))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if _, err := os.Stat("/tmp/config"); err == nil {
+		f, _ := os.Open("/tmp/data")
+		fmt.Println(f)
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -292,5 +292,64 @@ func main() {
 	_ = tls.Config{MinVersion: tls.VersionTLS12}
 	_ = cryptotls.Config{MinVersion: cryptotls.VersionTLS12}
 }
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+// Weak RC4 and 3DES ciphersuites
+package main
+
+import "crypto/tls"
+
+func main() {
+	_ = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		CipherSuites: []uint16{
+			tls.TLS_RSA_WITH_RC4_128_SHA,
+			tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
+		},
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// Strong ciphersuites only
+package main
+
+import "crypto/tls"
+
+func main() {
+	_ = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		CipherSuites: []uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+		},
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+// Free renegotiation as client
+package main
+
+import "crypto/tls"
+
+func main() {
+	_ = &tls.Config{
+		MinVersion:    tls.VersionTLS12,
+		Renegotiation: tls.RenegotiateFreelyAsClient,
+	}
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+// Single renegotiation as client
+package main
+
+import "crypto/tls"
+
+func main() {
+	_ = &tls.Config{
+		MinVersion:    tls.VersionTLS12,
+		Renegotiation: tls.RenegotiateOnceAsClient,
+	}
+}
 `}, 0, gosec.NewConfig()},
 }
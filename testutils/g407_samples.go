@@ -0,0 +1,122 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG407 - Use of block cipher in ECB mode
+var SampleCodeG407 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"fmt"
+)
+
+func main() {
+	key := make([]byte, 32)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	plaintext := []byte("exampleplaintextexampleplaintext")
+	ciphertext := make([]byte, len(plaintext))
+	for bs := block.BlockSize(); len(plaintext) > 0; plaintext, ciphertext = plaintext[bs:], ciphertext[bs:] {
+		block.Encrypt(ciphertext, plaintext[:bs])
+	}
+	fmt.Println(ciphertext)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+)
+
+func decrypt(block cipher.Block, ciphertext []byte) []byte {
+	bs := block.BlockSize()
+	plaintext := make([]byte, len(ciphertext))
+	for i := 0; i < len(ciphertext); i += bs {
+		block.Decrypt(plaintext[i:i+bs], ciphertext[i:i+bs])
+	}
+	return plaintext
+}
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 16))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(decrypt(block, make([]byte, 32)))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"fmt"
+)
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 16))
+	if err != nil {
+		panic(err)
+	}
+	blocks := [][]byte{make([]byte, 16), make([]byte, 16)}
+	for _, b := range blocks {
+		block.Encrypt(b, b)
+	}
+	fmt.Println(blocks)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 32))
+	if err != nil {
+		panic(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		panic(err)
+	}
+	for _, msg := range []string{"hello", "world"} {
+		fmt.Println(gcm.Seal(nil, nonce, []byte(msg), nil))
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"fmt"
+)
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 16))
+	if err != nil {
+		panic(err)
+	}
+	out := make([]byte, 16)
+	block.Encrypt(out, make([]byte, 16))
+	fmt.Println(out)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,239 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG408 - Insecure JWT parsing
+var SampleCodeG408 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("key")
+
+func main() {
+	token, err := jwt.Parse("token", func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("key")
+
+func main() {
+	token, err := jwt.Parse("token", func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return secret, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("key")
+
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return secret, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method")
+	}
+}
+
+func main() {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims("token", claims, keyFunc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("key")
+
+func keyFunc(_ *jwt.Token) (interface{}, error) {
+	return secret, nil
+}
+
+func main() {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims("token", claims, keyFunc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("key")
+
+func main() {
+	token, err := jwt.Parse("token", func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != "HS256" {
+		return nil, fmt.Errorf("unexpected signing method")
+	}
+	return []byte("key"), nil
+}
+
+func main() {
+	token, _ := jwt.Parse("token", keyFunc)
+	fmt.Println(token.Claims)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != "HS256" {
+		return nil, fmt.Errorf("unexpected signing method")
+	}
+	return []byte("key"), nil
+}
+
+func main() {
+	token, _ := jwt.Parse("token", keyFunc)
+	if !token.Valid {
+		panic("invalid token")
+	}
+	fmt.Println(token.Claims)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func main() {
+	parser := jwt.NewParser()
+	token, err := parser.Parse("token", func(token *jwt.Token) (interface{}, error) {
+		return []byte("key"), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var keys = map[string][]byte{"main": []byte("key")}
+
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	return keys[kid], nil
+}
+
+func main() {
+	token, err := jwt.Parse("token", keyFunc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func keyFunc(token *jwt.Token) (interface{}, error) {
+	if token.Header["alg"] != "HS256" {
+		return nil, fmt.Errorf("unexpected signing method")
+	}
+	return []byte("key"), nil
+}
+
+func main() {
+	token, err := jwt.Parse("token", keyFunc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(token.Claims)
+}
+`}, 0, gosec.NewConfig()},
+}
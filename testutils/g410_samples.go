@@ -0,0 +1,160 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG410 - Weak password hashing parameters
+var SampleCodeG410 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func main() {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), 8)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hash))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func main() {
+	cost := bcrypt.MinCost
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), cost)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hash))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func main() {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hash))
+	hash, err = bcrypt.GenerateFromPassword([]byte("password"), 14)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hash))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hash(password string, cost int) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), cost)
+}
+
+func main() {
+	fmt.Println(hash("password", 8))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func main() {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), 12)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(hash))
+}
+`}, 1, gosec.Config{"G410": map[string]interface{}{"bcrypt_cost": "14"}}},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+func main() {
+	key, err := scrypt.Key([]byte("password"), []byte("salt"), 16384, 8, 1, 32)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(key)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+func main() {
+	key, err := scrypt.Key([]byte("password"), []byte("salt"), 1<<15, 8, 1, 32)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(key)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+func main() {
+	key := argon2.IDKey([]byte("password"), []byte("salt"), 1, 8*1024, 4, 32)
+	fmt.Println(key)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+)
+
+func main() {
+	key := argon2.IDKey([]byte("password"), []byte("salt"), 1, 64*1024, 4, 32)
+	fmt.Println(key)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,122 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG411 - Insecure gRPC transport
+var SampleCodeG411 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"google.golang.org/grpc"
+)
+
+func main() {
+	conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func main() {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS13})
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(creds))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+func main() {
+	lis, err := net.Listen("tcp", "localhost:50051")
+	if err != nil {
+		panic(err)
+	}
+	server := grpc.NewServer()
+	if err := server.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+func main() {
+	lis, err := net.Listen("tcp", "localhost:50051")
+	if err != nil {
+		panic(err)
+	}
+	server := grpc.NewServer()
+	if err := server.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 1, gosec.Config{"G411": map[string]interface{}{"strict": true}}},
+	{[]string{`
+package main
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func main() {
+	creds, err := credentials.NewServerTLSFromFile("server.crt", "server.key")
+	if err != nil {
+		panic(err)
+	}
+	lis, err := net.Listen("tcp", "localhost:50051")
+	if err != nil {
+		panic(err)
+	}
+	server := grpc.NewServer(grpc.Creds(creds))
+	if err := server.Serve(lis); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.Config{"G411": map[string]interface{}{"strict": true}}},
+}
@@ -0,0 +1,82 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG412 - Broken TLS certificate verification
+var SampleCodeG412 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+)
+
+func main() {
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+				return nil
+			},
+		},
+	}
+	client := &http.Client{Transport: tr}
+	_, _ = client.Get("https://example.com")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"log"
+	"net/http"
+)
+
+func verifyConnection(cs tls.ConnectionState) error {
+	log.Println("connected to", cs.ServerName)
+	return nil
+}
+
+func main() {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	cfg.VerifyConnection = verifyConnection
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+	_, _ = client.Get("https://example.com")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+func main() {
+	roots := x509.NewCertPool()
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				if len(rawCerts) == 0 {
+					return errors.New("no certificate presented")
+				}
+				cert, err := x509.ParseCertificate(rawCerts[0])
+				if err != nil {
+					return err
+				}
+				_, err = cert.Verify(x509.VerifyOptions{Roots: roots})
+				return err
+			},
+		},
+	}
+	client := &http.Client{Transport: tr}
+	_, _ = client.Get("https://example.com")
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,126 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG413 - Secret compared in non-constant time
+var SampleCodeG413 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+)
+
+func verify(message, messageMAC, key []byte) bool {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	expected := mac.Sum(nil)
+	return bytes.Equal(messageMAC, expected)
+}
+
+func main() {
+	fmt.Println(verify([]byte("message"), []byte("mac"), []byte("key")))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+func verify(message []byte, received string, key []byte) bool {
+	h := hmac.New(sha256.New, key)
+	h.Write(message)
+	return hex.EncodeToString(h.Sum(nil)) == received
+}
+
+func main() {
+	fmt.Println(verify([]byte("message"), "abc", []byte("key")))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var apiToken = "from-config"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	provided := r.Header.Get("X-Api-Key")
+	if provided != apiToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+)
+
+func verify(message, messageMAC, key []byte) bool {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	expected := mac.Sum(nil)
+	return hmac.Equal(messageMAC, expected)
+}
+
+func main() {
+	fmt.Println(verify([]byte("message"), []byte("mac"), []byte("key")))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+)
+
+func check(token, expectedToken string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) == 1
+}
+
+func main() {
+	fmt.Println(check("a", "b"))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	token := os.Getenv("TOKEN")
+	if token == "" {
+		fmt.Println("missing token")
+	}
+	name := os.Getenv("NAME")
+	if name == os.Getenv("USER") {
+		fmt.Println("same")
+	}
+}
+`}, 0, gosec.NewConfig()},
+}
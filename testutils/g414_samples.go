@@ -0,0 +1,203 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG414 - TLS hostname verification bypassed
+var SampleCodeG414 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+func main() {
+	conn, err := tls.Dial("tcp", "10.0.0.1:443", &tls.Config{ServerName: ""})
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	fmt.Println(conn.ConnectionState().Version)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+)
+
+func main() {
+	conn, err := tls.Dial("tcp", "10.0.0.1:443", &tls.Config{ServerName: "api.example.com"})
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	fmt.Println(conn.ConnectionState().Version)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func newClient(host string) *http.Client {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	cfg.ServerName = ""
+	if host != "" {
+		cfg.NextProtos = []string{"h2"}
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+}
+
+func main() {
+	resp, err := newClient("10.0.0.1").Get("https://10.0.0.1")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func main() {
+	server := &http.Server{
+		Addr:      ":8443",
+		TLSConfig: &tls.Config{ServerName: "", MinVersion: tls.VersionTLS12},
+	}
+	if err := server.ListenAndServeTLS("cert.pem", "key.pem"); err != nil {
+		panic(err)
+	}
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+)
+
+func main() {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	cfg.ServerName = ""
+	listener, err := tls.Listen("tcp", ":8443", cfg)
+	if err != nil {
+		panic(err)
+	}
+	defer listener.Close()
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func main() {
+	cfg := &tls.Config{ServerName: "", MinVersion: tls.VersionTLS12}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+	resp, err := client.Get("https://10.0.0.1")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+func main() {
+	transport := &http.Transport{
+		DialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"net"
+	"net/http"
+)
+
+func dialTLS(network, addr string) (net.Conn, error) {
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func main() {
+	transport := &http.Transport{}
+	transport.DialTLS = dialTLS
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+)
+
+func main() {
+	transport := &http.Transport{
+		DialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+			return tls.Client(conn, &tls.Config{ServerName: host}), nil
+		},
+	}
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get("https://example.com")
+	if err != nil {
+		panic(err)
+	}
+	defer resp.Body.Close()
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,156 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG415 - Predictable seed of the random number generator
+var SampleCodeG415 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+func main() {
+	rand.Seed(42)
+	fmt.Println(rand.Intn(100))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+func main() {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	fmt.Println(r.Intn(100))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+func main() {
+	seed := time.Now().Unix()
+	rand.Seed(seed)
+	fmt.Println(rand.Intn(100))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const seed = 1234
+
+func main() {
+	r := rand.New(rand.NewSource(seed))
+	r.Seed(seed)
+	fmt.Println(r.Intn(100))
+}
+`}, 2, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+func main() {
+	fmt.Println(rand.Intn(100))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+func main() {
+	n, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		panic(err)
+	}
+	seed := n.Int64()
+	r := rand.New(rand.NewSource(seed))
+	fmt.Println(r.Intn(100))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+func main() {
+	n, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		panic(err)
+	}
+	rand.Seed(n.Int64())
+	fmt.Println(rand.Intn(100))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+func main() {
+	n, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		panic(err)
+	}
+	r := rand.New(rand.NewSource(n.Int64()))
+	n, err = crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		panic(err)
+	}
+	r.Seed(n.Int64())
+	fmt.Println(r.Intn(100))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+func main() {
+	r := rand.New(rand.NewPCG(1, 2))
+	fmt.Println(r.IntN(100))
+}
+`}, 1, gosec.NewConfig()},
+}
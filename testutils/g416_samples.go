@@ -0,0 +1,89 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG416 - TLS configuration shared between a server and a client
+var SampleCodeG416 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func main() {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	server := &http.Server{
+		Addr:      ":8443",
+		TLSConfig: config,
+	}
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: config},
+	}
+	_, _ = client.Get("https://example.com")
+	_ = server.ListenAndServeTLS("cert.pem", "key.pem")
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+)
+
+func main() {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	listener, err := tls.Listen("tcp", ":8443", config)
+	if err != nil {
+		panic(err)
+	}
+	defer listener.Close()
+	conn, err := tls.Dial("tcp", "upstream.example.com:443", config)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func main() {
+	serverConfig := &tls.Config{MinVersion: tls.VersionTLS12, ClientAuth: tls.RequireAndVerifyClientCert}
+	clientConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	server := &http.Server{
+		Addr:      ":8443",
+		TLSConfig: serverConfig,
+	}
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: clientConfig},
+	}
+	_, _ = client.Get("https://example.com")
+	_ = server.ListenAndServeTLS("cert.pem", "key.pem")
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+func main() {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	server := &http.Server{Addr: ":8443"}
+	server.TLSConfig = config
+	transport := &http.Transport{}
+	transport.TLSClientConfig = config
+	_ = transport
+	_ = server.ListenAndServeTLS("cert.pem", "key.pem")
+}
+`}, 1, gosec.NewConfig()},
+}
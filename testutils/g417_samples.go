@@ -0,0 +1,61 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG417 - Database connection strings which disable TLS
+var SampleCodeG417 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"database/sql"
+	"os"
+)
+
+func main() {
+	dsn := "host=db.internal port=5432 user=app dbname=orders sslmode=disable"
+	db, err := sql.Open("postgres", dsn+" password="+os.Getenv("DB_PASSWORD"))
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main() {
+	dsn := fmt.Sprintf("app:%s@tcp(db.internal:3306)/orders?parseTime=true&tls=false", os.Getenv("DB_PASSWORD"))
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main() {
+	db, err := sql.Open("postgres", "postgres://app@db.internal:5432/orders?sslmode=verify-full")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+	dsn := fmt.Sprintf("app:%s@tcp(db.internal:3306)/orders?tls=true", os.Getenv("DB_PASSWORD"))
+	fmt.Println(len(dsn), "tls=false")
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,71 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG419 - Error of a crypto/rand read is ignored
+var SampleCodeG419 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+func main() {
+	key := make([]byte, 32)
+	rand.Read(key)
+	fmt.Printf("%x\n", key)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+func main() {
+	nonce := make([]byte, 12)
+	_, _ = io.ReadFull(rand.Reader, nonce)
+	fmt.Printf("%x\n", nonce)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+func main() {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x %x\n", key, nonce)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+func main() {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	fmt.Println(buf)
+}
+`}, 0, gosec.NewConfig()},
+}
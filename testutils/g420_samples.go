@@ -0,0 +1,109 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG420 - JWT signed with a hardcoded key
+var SampleCodeG420 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func main() {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"})
+	signed, err := token.SignedString([]byte("my-secret"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(signed)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const signingKey = "my-secret"
+
+var key = []byte(signingKey)
+
+func main() {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"})
+	signed, err := token.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(signed)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func main() {
+	signature, err := jwt.SigningMethodHS256.Sign("header.payload", []byte("my-secret"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(signature)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func main() {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"})
+	signed, err := token.SignedString([]byte(os.Getenv("JWT_KEY")))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(signed)
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type config struct {
+	JWTKey []byte
+}
+
+func sign(conf config) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"})
+	return token.SignedString(conf.JWTKey)
+}
+
+func main() {
+	signed, err := sign(config{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(signed)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,68 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG421 - HMACs computed with a weak hash function
+var SampleCodeG421 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"fmt"
+)
+
+func main() {
+	mac := hmac.New(md5.New, []byte("key"))
+	mac.Write([]byte("message"))
+	fmt.Printf("%x\n", mac.Sum(nil))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"fmt"
+	"hash"
+)
+
+func main() {
+	mac := hmac.New(func() hash.Hash { return sha1.New() }, []byte("key"))
+	mac.Write([]byte("message"))
+	fmt.Printf("%x\n", mac.Sum(nil))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+)
+
+func main() {
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte("message"))
+	fmt.Printf("%x\n", mac.Sum(nil))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"fmt"
+)
+
+func main() {
+	mac := hmac.New(sha1.New, []byte("key"))
+	mac.Write([]byte("message"))
+	fmt.Printf("%x\n", mac.Sum(nil))
+}
+`}, 0, gosec.Config{"G421": map[string]interface{}{"allowed": []interface{}{"sha1"}}}},
+}
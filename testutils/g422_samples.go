@@ -0,0 +1,81 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG422 - Unchecked errors of cryptographic operations
+var SampleCodeG422 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+)
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 32))
+	if err != nil {
+		panic(err)
+	}
+	gcm, _ := cipher.NewGCM(block)
+	fmt.Println(gcm.NonceSize())
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+)
+
+func verify(key *rsa.PublicKey, message, signature []byte) {
+	digest := sha256.Sum256(message)
+	rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+}
+
+func main() {
+	verify(nil, nil, nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/cipher"
+	"fmt"
+)
+
+func decrypt(aead cipher.AEAD, nonce, ciphertext []byte) {
+	plaintext, _ := aead.Open(nil, nonce, ciphertext, nil)
+	fmt.Println(string(plaintext))
+}
+
+func main() {
+	decrypt(nil, nil, nil)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+)
+
+func main() {
+	block, err := aes.NewCipher(make([]byte, 32))
+	if err != nil {
+		panic(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(gcm.NonceSize())
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,70 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG423 - Predictable UUIDs and tokens
+var SampleCodeG423 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	id := uuid.NewMD5(uuid.NameSpaceURL, []byte("user@example.com"))
+	fmt.Println(id)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func generateToken(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[rand.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+func main() {
+	fmt.Println(generateToken(32))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+func main() {
+	sessionID := fmt.Sprintf("%x", rand.Int63())
+	fmt.Println(sessionID)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	id := uuid.New()
+	fmt.Println(id)
+}
+`}, 0, gosec.NewConfig()},
+}
@@ -0,0 +1,109 @@
+package testutils
+
+import "github.com/securego/gosec/v2"
+
+// SampleCodeG603 - Unsafe pointer arithmetic and unchecked lengths
+var SampleCodeG603 = []CodeSample{
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func main() {
+	values := [4]byte{1, 2, 3, 4}
+	next := uintptr(unsafe.Pointer(&values[0])) + unsafe.Sizeof(values[0])
+	fmt.Printf("%x\n", next)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func main() {
+	values := [4]byte{1, 2, 3, 4}
+	base := uintptr(unsafe.Pointer(&values[0]))
+	fmt.Printf("%x\n", base-1)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"unsafe"
+)
+
+var buffer [16]byte
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(r.URL.Query().Get("n"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data := unsafe.Slice(&buffer[0], n)
+	fmt.Fprint(w, data)
+}
+
+func main() {
+	http.HandleFunc("/", handler)
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func view(p *byte, n int) string {
+	return unsafe.String(p, n)
+}
+
+func main() {
+	b := []byte("hello")
+	fmt.Println(view(&b[0], 5))
+}
+`}, 1, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func main() {
+	var x struct {
+		a int32
+		b int64
+	}
+	fmt.Println(unsafe.Sizeof(x), unsafe.Alignof(x.b), unsafe.Offsetof(x.b))
+}
+`}, 0, gosec.NewConfig()},
+	{[]string{`
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func main() {
+	b := []byte("hello")
+	s := unsafe.String(&b[0], len(b))
+	arr := [4]byte{1, 2, 3, 4}
+	fmt.Println(s, unsafe.Slice(&arr[0], 4))
+}
+`}, 0, gosec.NewConfig()},
+}
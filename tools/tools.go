@@ -5,8 +5,12 @@ package tools
 
 // nolint
 import (
+	_ "github.com/golang-jwt/jwt/v5"
+	_ "github.com/gorilla/sessions"
 	_ "github.com/lib/pq"
+	_ "github.com/rs/cors"
 	_ "golang.org/x/crypto/ssh"
 	_ "golang.org/x/lint/golint"
 	_ "golang.org/x/text"
+	_ "google.golang.org/grpc"
 )